@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/attachments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	blockstorageV3AttachmentCompleteMicroversion = "3.44"
+	blockstorageV3AttachmentModeMicroversion     = "3.54"
+)
+
+func expandBlockStorageAttachmentV3Connector(v []any) map[string]any {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	raw := v[0].(map[string]any)
+	connector := make(map[string]any)
+
+	for _, key := range []string{"host", "ip", "initiator", "os_type", "platform", "mountpoint", "nqn"} {
+		if value, ok := raw[key].(string); ok && value != "" {
+			connector[key] = value
+		}
+	}
+
+	if value, ok := raw["multipath"].(bool); ok {
+		connector["multipath"] = value
+	}
+
+	for _, key := range []string{"wwpns", "wwnns"} {
+		if value, ok := raw[key].([]any); ok && len(value) > 0 {
+			connector[key] = expandToStringSlice(value)
+		}
+	}
+
+	for key, value := range expandToMapStringString(raw["value_specs"].(map[string]any)) {
+		connector[key] = value
+	}
+
+	return connector
+}
+
+// flattenBlockStorageAttachmentV3ConnectionInfo splits the connection info
+// returned by Cinder into its driver volume type and the string values of the
+// driver specific connection data.
+func flattenBlockStorageAttachmentV3ConnectionInfo(connInfo map[string]any) (string, map[string]string) {
+	data := make(map[string]string)
+
+	if len(connInfo) == 0 {
+		return "", data
+	}
+
+	driverVolumeType, _ := connInfo["driver_volume_type"].(string)
+
+	raw := connInfo
+	if v, ok := connInfo["data"].(map[string]any); ok {
+		raw = v
+	}
+
+	for key, value := range raw {
+		if key == "driver_volume_type" {
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			data[key] = v
+		case bool, float64:
+			data[key] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return driverVolumeType, data
+}
+
+func blockStorageAttachmentV3StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, attachmentID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		a, err := attachments.Get(ctx, client, attachmentID).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return a, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if a.Status == "error_attaching" || a.Status == "error_detaching" {
+			return a, a.Status, errors.New("The attachment is in error status. " +
+				"Please check with your cloud admin or check the Block Storage " +
+				"API logs to see why this error occurred.")
+		}
+
+		return a, a.Status, nil
+	}
+}
+
+// blockStorageAttachmentV3Complete marks the attachment as completed and waits
+// for it to become attached. Attachments without a connector stay reserved.
+func blockStorageAttachmentV3Complete(ctx context.Context, d *schema.ResourceData, client *gophercloud.ServiceClient, timeout string) error {
+	if !d.Get("complete").(bool) || len(d.Get("connector").([]any)) == 0 {
+		return nil
+	}
+
+	if err := attachments.Complete(ctx, client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error completing openstack_blockstorage_attachment_v3 %s: %w", d.Id(), err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"reserved", "attaching"},
+		Target:     []string{"attached"},
+		Refresh:    blockStorageAttachmentV3StateRefreshFunc(ctx, client, d.Id()),
+		Timeout:    d.Timeout(timeout),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for openstack_blockstorage_attachment_v3 %s to become attached: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExpandBlockStorageAttachmentV3Connector(t *testing.T) {
+	raw := []any{
+		map[string]any{
+			"host":        "baremetal-1",
+			"ip":          "192.168.255.10",
+			"initiator":   "iqn.1993-08.org.debian:01:e9861fb1859",
+			"multipath":   false,
+			"os_type":     "linux2",
+			"platform":    "",
+			"mountpoint":  "",
+			"nqn":         "",
+			"wwpns":       []any{},
+			"wwnns":       []any{"2000000000000001"},
+			"value_specs": map[string]any{"do_local_attach": "false"},
+		},
+	}
+
+	expected := map[string]any{
+		"host":            "baremetal-1",
+		"ip":              "192.168.255.10",
+		"initiator":       "iqn.1993-08.org.debian:01:e9861fb1859",
+		"multipath":       false,
+		"os_type":         "linux2",
+		"wwnns":           []string{"2000000000000001"},
+		"do_local_attach": "false",
+	}
+
+	assert.Equal(t, expected, expandBlockStorageAttachmentV3Connector(raw))
+	assert.Nil(t, expandBlockStorageAttachmentV3Connector(nil))
+}
+
+func TestUnitFlattenBlockStorageAttachmentV3ConnectionInfo(t *testing.T) {
+	connInfo := map[string]any{
+		"driver_volume_type": "iscsi",
+		"data": map[string]any{
+			"target_iqn":      "iqn.2010-10.org.openstack:volume-1",
+			"target_lun":      float64(0),
+			"target_discover": false,
+			"auth_method":     "CHAP",
+			"target_portals":  []any{"192.168.255.1:3260"},
+		},
+	}
+
+	expected := map[string]string{
+		"target_iqn":      "iqn.2010-10.org.openstack:volume-1",
+		"target_lun":      "0",
+		"target_discover": "false",
+		"auth_method":     "CHAP",
+	}
+
+	driverVolumeType, data := flattenBlockStorageAttachmentV3ConnectionInfo(connInfo)
+	assert.Equal(t, "iscsi", driverVolumeType)
+	assert.Equal(t, expected, data)
+
+	driverVolumeType, data = flattenBlockStorageAttachmentV3ConnectionInfo(nil)
+	assert.Empty(t, driverVolumeType)
+	assert.Empty(t, data)
+}
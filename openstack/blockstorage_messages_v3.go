@@ -0,0 +1,128 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Listing messages was added in 3.3, filtering and sorting them in 3.5.
+const blockstorageV3MessagesMicroversion = "3.5"
+
+// blockStorageV3Message represents a Cinder user message.
+type blockStorageV3Message struct {
+	ID           string `json:"id"`
+	ResourceType string `json:"resource_type"`
+	ResourceUUID string `json:"resource_uuid"`
+	RequestID    string `json:"request_id"`
+	EventID      string `json:"event_id"`
+	MessageLevel string `json:"message_level"`
+	UserMessage  string `json:"user_message"`
+	CreatedAt    string `json:"created_at"`
+	ExpiresAt    string `json:"expires_at"`
+}
+
+type blockStorageV3MessagesListOpts struct {
+	ResourceUUID string `q:"resource_uuid"`
+	ResourceType string `q:"resource_type"`
+	EventID      string `q:"event_id"`
+	MessageLevel string `q:"message_level"`
+	RequestID    string `q:"request_id"`
+	Sort         string `q:"sort"`
+	Limit        int    `q:"limit"`
+}
+
+func blockStorageV3MessagesURL(client *gophercloud.ServiceClient, opts blockStorageV3MessagesListOpts) (string, error) {
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return client.ServiceURL("messages") + query.String(), nil
+}
+
+func blockStorageV3ListMessages(ctx context.Context, client *gophercloud.ServiceClient, opts blockStorageV3MessagesListOpts) ([]blockStorageV3Message, error) {
+	// Work on a copy, so that the microversion of the caller's client is kept.
+	c := *client
+	bumpClientMicroversion(&c, blockstorageV3MessagesMicroversion)
+
+	url, err := blockStorageV3MessagesURL(&c, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAllPages[blockStorageV3Message](ctx, &c, url, "messages")
+}
+
+// blockStorageV3LatestMessage returns the latest user message of a Cinder
+// resource or nil, when there is none or the messages can't be retrieved.
+func blockStorageV3LatestMessage(ctx context.Context, client *gophercloud.ServiceClient, id string) *blockStorageV3Message {
+	listOpts := blockStorageV3MessagesListOpts{
+		ResourceUUID: id,
+		Sort:         "created_at:desc",
+		Limit:        1,
+	}
+
+	c := *client
+	bumpClientMicroversion(&c, blockstorageV3MessagesMicroversion)
+
+	url, err := blockStorageV3MessagesURL(&c, listOpts)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to build the Block Storage messages query for %s: %v", id, err)
+
+		return nil
+	}
+
+	// Only the first page is needed, so the next links are not followed.
+	var res struct {
+		Messages []blockStorageV3Message `json:"messages"`
+	}
+
+	_, err = c.Get(ctx, url, &res, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve Block Storage messages for %s: %v", id, err)
+
+		return nil
+	}
+
+	allMessages := res.Messages
+	if len(allMessages) == 0 {
+		log.Printf("[DEBUG] No Block Storage messages found for %s", id)
+
+		return nil
+	}
+
+	return &allMessages[0]
+}
+
+// blockStorageV3ErrorWithMessage appends the latest Cinder user message of a
+// resource to an error.
+func blockStorageV3ErrorWithMessage(ctx context.Context, client *gophercloud.ServiceClient, id string, err error) error {
+	msg := blockStorageV3LatestMessage(ctx, client, id)
+	if msg == nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: the latest cinder message (%s, event %s): %s", err, msg.CreatedAt, msg.EventID, msg.UserMessage)
+}
+
+func flattenBlockStorageV3Messages(messages []blockStorageV3Message) []map[string]any {
+	res := make([]map[string]any, len(messages))
+	for i, m := range messages {
+		res[i] = map[string]any{
+			"id":            m.ID,
+			"resource_id":   m.ResourceUUID,
+			"resource_type": m.ResourceType,
+			"event_id":      m.EventID,
+			"message_level": m.MessageLevel,
+			"request_id":    m.RequestID,
+			"user_message":  m.UserMessage,
+			"created_at":    m.CreatedAt,
+			"expires_at":    m.ExpiresAt,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,102 @@
+package openstack
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBlockStorageV3ErrorWithMessage(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+		th.TestHeader(t, r, "OpenStack-API-Version", "volume 3.5")
+		th.TestFormValues(t, r, map[string]string{
+			"resource_uuid": "289da7f8-6440-407c-9fb4-7db01ec49164",
+			"sort":          "created_at:desc",
+			"limit":         "1",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "messages": [
+    {
+      "id": "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+      "resource_type": "VOLUME",
+      "resource_uuid": "289da7f8-6440-407c-9fb4-7db01ec49164",
+      "request_id": "req-c1216709-afba-4703-a1a3-22eda88f2f5a",
+      "event_id": "VOLUME_000002",
+      "message_level": "ERROR",
+      "user_message": "create volume: No storage could be allocated for this volume request.",
+      "created_at": "2014-10-28T00:00:00-00:00",
+      "expires_at": "2014-10-29T00:00:00-00:00"
+    }
+  ]
+}`)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+	client.Type = "block-storage"
+
+	err := blockStorageV3ErrorWithMessage(t.Context(), client, "289da7f8-6440-407c-9fb4-7db01ec49164", errors.New("The volume is in error status"))
+	assert.Empty(t, client.Microversion)
+
+	expected := "The volume is in error status: the latest cinder message (2014-10-28T00:00:00-00:00, event VOLUME_000002): " +
+		"create volume: No storage could be allocated for this volume request."
+	assert.EqualError(t, err, expected)
+}
+
+func TestUnitBlockStorageV3ErrorWithMessageNoMessages(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/messages", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"messages": []}`)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+	origErr := errors.New("The volume is in error status")
+
+	err := blockStorageV3ErrorWithMessage(t.Context(), client, "289da7f8-6440-407c-9fb4-7db01ec49164", origErr)
+	assert.Equal(t, origErr, err)
+}
+
+func TestUnitFlattenBlockStorageV3Messages(t *testing.T) {
+	messages := []blockStorageV3Message{
+		{
+			ID:           "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+			ResourceType: "VOLUME",
+			ResourceUUID: "289da7f8-6440-407c-9fb4-7db01ec49164",
+			RequestID:    "req-c1216709-afba-4703-a1a3-22eda88f2f5a",
+			EventID:      "VOLUME_000002",
+			MessageLevel: "ERROR",
+			UserMessage:  "create volume: No storage could be allocated for this volume request.",
+			CreatedAt:    "2014-10-28T00:00:00-00:00",
+			ExpiresAt:    "2014-10-29T00:00:00-00:00",
+		},
+	}
+
+	expected := []map[string]any{
+		{
+			"id":            "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+			"resource_id":   "289da7f8-6440-407c-9fb4-7db01ec49164",
+			"resource_type": "VOLUME",
+			"event_id":      "VOLUME_000002",
+			"message_level": "ERROR",
+			"request_id":    "req-c1216709-afba-4703-a1a3-22eda88f2f5a",
+			"user_message":  "create volume: No storage could be allocated for this volume request.",
+			"created_at":    "2014-10-28T00:00:00-00:00",
+			"expires_at":    "2014-10-29T00:00:00-00:00",
+		},
+	}
+
+	assert.Equal(t, expected, flattenBlockStorageV3Messages(messages))
+}
@@ -0,0 +1,127 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/schedulerstats"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
+)
+
+// blockStorageV3PoolCapacity converts a capacity reported by the scheduler
+// into a value that can be stored in the state. Backends reporting an
+// "infinite" capacity are represented as -1.
+func blockStorageV3PoolCapacity(v float64) float64 {
+	if math.IsInf(v, 0) {
+		return -1
+	}
+
+	return v
+}
+
+func flattenBlockStorageV3Pools(pools []schedulerstats.StoragePool) []map[string]any {
+	res := make([]map[string]any, len(pools))
+	for i, p := range pools {
+		c := p.Capabilities
+		res[i] = map[string]any{
+			"name":                        p.Name,
+			"volume_backend_name":         c.VolumeBackendName,
+			"vendor_name":                 c.VendorName,
+			"driver_version":              c.DriverVersion,
+			"storage_protocol":            c.StorageProtocol,
+			"total_capacity_gb":           blockStorageV3PoolCapacity(c.TotalCapacityGB),
+			"free_capacity_gb":            blockStorageV3PoolCapacity(c.FreeCapacityGB),
+			"allocated_capacity_gb":       blockStorageV3PoolCapacity(c.AllocatedCapacityGB),
+			"provisioned_capacity_gb":     c.ProvisionedCapacityGB,
+			"reserved_percentage":         int(c.ReservedPercentage),
+			"max_over_subscription_ratio": c.MaxOverSubscriptionRatio,
+			"thin_provisioning_support":   c.ThinProvisioningSupport,
+			"thick_provisioning_support":  c.ThickProvisioningSupport,
+			"qos_support":                 c.QoSSupport,
+			"multiattach":                 c.Multiattach,
+			"total_volumes":               int(c.TotalVolumes),
+		}
+	}
+
+	return res
+}
+
+// blockStorageV3ExtraSpecBool parses a boolean volume type extra spec, which
+// may be either a plain boolean or a "<is> True" style scheduler filter.
+func blockStorageV3ExtraSpecBool(v string) (bool, bool) {
+	v = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(v), "<is>"))
+	switch strings.ToLower(v) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+
+	return false, false
+}
+
+// blockStorageV3PoolMatchesExtraSpecs reports whether a pool satisfies the
+// extra specs of a volume type, considering only the capabilities exposed by
+// the scheduler stats API.
+func blockStorageV3PoolMatchesExtraSpecs(pool schedulerstats.StoragePool, extraSpecs map[string]string) bool {
+	c := pool.Capabilities
+
+	for k, v := range extraSpecs {
+		var actual bool
+
+		switch k {
+		case "volume_backend_name":
+			if v != c.VolumeBackendName {
+				return false
+			}
+
+			continue
+		case "multiattach":
+			actual = c.Multiattach
+		case "QoS_support":
+			actual = c.QoSSupport
+		case "thin_provisioning_support":
+			actual = c.ThinProvisioningSupport
+		case "thick_provisioning_support":
+			actual = c.ThickProvisioningSupport
+		default:
+			continue
+		}
+
+		expected, ok := blockStorageV3ExtraSpecBool(v)
+		if ok && expected != actual {
+			return false
+		}
+	}
+
+	return true
+}
+
+func blockStorageV3VolumeTypeExtraSpecs(ctx context.Context, client *gophercloud.ServiceClient, volumeType string) (map[string]string, error) {
+	allPages, err := volumetypes.List(client, volumetypes.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing volume types: %w", err)
+	}
+
+	allTypes, err := volumetypes.ExtractVolumeTypes(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Error extracting volume types: %w", err)
+	}
+
+	for _, vt := range allTypes {
+		if vt.ID != volumeType && vt.Name != volumeType {
+			continue
+		}
+
+		if vt.ExtraSpecs != nil {
+			return vt.ExtraSpecs, nil
+		}
+
+		return volumetypes.ListExtraSpecs(ctx, client, vt.ID).Extract()
+	}
+
+	return nil, fmt.Errorf("Unable to find volume type %s", volumeType)
+}
@@ -0,0 +1,77 @@
+package openstack
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/schedulerstats"
+	"github.com/stretchr/testify/assert"
+)
+
+func blockStorageV3PoolFixture() schedulerstats.StoragePool {
+	return schedulerstats.StoragePool{
+		Name: "cinder@lvmdriver-1#lvmdriver-1",
+		Capabilities: schedulerstats.Capabilities{
+			VolumeBackendName:        "lvmdriver-1",
+			VendorName:               "Open Source",
+			DriverVersion:            "3.0.0",
+			StorageProtocol:          "iSCSI",
+			TotalCapacityGB:          math.Inf(1),
+			FreeCapacityGB:           21.5,
+			AllocatedCapacityGB:      3,
+			ProvisionedCapacityGB:    3,
+			ReservedPercentage:       0,
+			MaxOverSubscriptionRatio: "20.0",
+			ThinProvisioningSupport:  true,
+			QoSSupport:               false,
+			Multiattach:              true,
+			TotalVolumes:             2,
+		},
+	}
+}
+
+func TestUnitFlattenBlockStorageV3Pools(t *testing.T) {
+	expected := []map[string]any{
+		{
+			"name":                        "cinder@lvmdriver-1#lvmdriver-1",
+			"volume_backend_name":         "lvmdriver-1",
+			"vendor_name":                 "Open Source",
+			"driver_version":              "3.0.0",
+			"storage_protocol":            "iSCSI",
+			"total_capacity_gb":           float64(-1),
+			"free_capacity_gb":            21.5,
+			"allocated_capacity_gb":       float64(3),
+			"provisioned_capacity_gb":     float64(3),
+			"reserved_percentage":         0,
+			"max_over_subscription_ratio": "20.0",
+			"thin_provisioning_support":   true,
+			"thick_provisioning_support":  false,
+			"qos_support":                 false,
+			"multiattach":                 true,
+			"total_volumes":               2,
+		},
+	}
+
+	actual := flattenBlockStorageV3Pools([]schedulerstats.StoragePool{blockStorageV3PoolFixture()})
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitBlockStorageV3PoolMatchesExtraSpecs(t *testing.T) {
+	pool := blockStorageV3PoolFixture()
+
+	assert.True(t, blockStorageV3PoolMatchesExtraSpecs(pool, nil))
+	assert.True(t, blockStorageV3PoolMatchesExtraSpecs(pool, map[string]string{
+		"volume_backend_name": "lvmdriver-1",
+		"multiattach":         "<is> True",
+		"unknown_spec":        "foo",
+	}))
+	assert.False(t, blockStorageV3PoolMatchesExtraSpecs(pool, map[string]string{
+		"volume_backend_name": "ceph",
+	}))
+	assert.False(t, blockStorageV3PoolMatchesExtraSpecs(pool, map[string]string{
+		"QoS_support": "<is> True",
+	}))
+	assert.True(t, blockStorageV3PoolMatchesExtraSpecs(pool, map[string]string{
+		"thin_provisioning_support": "true",
+	}))
+}
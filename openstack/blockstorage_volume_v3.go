@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
@@ -16,8 +17,26 @@ import (
 const (
 	blockstorageV3VolumeFromBackupMicroversion = "3.47"
 	blockstorageV3ResizeOnlineInUse            = "3.42"
+	blockstorageV3MigrateClusterMicroversion   = "3.16"
 )
 
+// blockStorageV3VolumeMigration holds the volume attributes related to
+// migrations, which are not exposed by gophercloud.
+type blockStorageV3VolumeMigration struct {
+	Status          string `json:"status"`
+	VolumeType      string `json:"volume_type"`
+	Host            string `json:"os-vol-host-attr:host"`
+	MigrationStatus string `json:"migration_status"`
+	MigStat         string `json:"os-vol-mig-status-attr:migstat"`
+}
+
+type blockStorageV3MigrateOpts struct {
+	Host          string `json:"host,omitempty"`
+	Cluster       string `json:"cluster,omitempty"`
+	ForceHostCopy bool   `json:"force_host_copy,omitempty"`
+	LockVolume    bool   `json:"lock_volume,omitempty"`
+}
+
 func flattenBlockStorageVolumeV3Attachments(v []volumes.Attachment) []map[string]any {
 	attachments := make([]map[string]any, len(v))
 	for i, attachment := range v {
@@ -42,9 +61,9 @@ func blockStorageVolumeV3StateRefreshFunc(ctx context.Context, client *gopherclo
 		}
 
 		if v.Status == "error" {
-			return v, v.Status, errors.New("The volume is in error status. " +
-				"Please check with your cloud admin or check the Block Storage " +
-				"API logs to see why this error occurred.")
+			return v, v.Status, blockStorageV3ErrorWithMessage(ctx, client, volumeID, errors.New("The volume is in error status. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred."))
 		}
 
 		return v, v.Status, nil
@@ -138,3 +157,89 @@ func resourceBlockStorageVolumeV3SchedulerHints(schedulerHintsRaw map[string]any
 
 	return schedulerHints
 }
+
+func blockStorageV3GetVolumeMigration(ctx context.Context, client *gophercloud.ServiceClient, volumeID string) (*blockStorageV3VolumeMigration, error) {
+	var v blockStorageV3VolumeMigration
+
+	err := volumes.Get(ctx, client, volumeID).ExtractIntoStructPtr(&v, "volume")
+	if err != nil {
+		return nil, err
+	}
+
+	if v.MigrationStatus == "" {
+		v.MigrationStatus = v.MigStat
+	}
+
+	return &v, nil
+}
+
+func blockStorageV3MigrateVolume(ctx context.Context, client *gophercloud.ServiceClient, volumeID string, opts blockStorageV3MigrateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "os-migrate_volume")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("volumes", volumeID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+// blockStorageVolumeV3MigrationStateRefreshFunc reports "retyping" or
+// "migrating" while a retype or a migration of the volume is in progress and
+// "done" once the volume settled.
+//
+// Cinder keeps the migration_status of the last migration, so an "error"
+// which was already reported before the operation (previousMigrationStatus)
+// only counts as a failure once a new migration was seen in progress.
+func blockStorageVolumeV3MigrationStateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, volumeID, previousMigrationStatus string) retry.StateRefreshFunc {
+	var migrating bool
+
+	return func() (any, string, error) {
+		v, err := blockStorageV3GetVolumeMigration(ctx, client, volumeID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch {
+		case v.Status == "error":
+			return v, v.Status, errors.New("The volume is in error status")
+		case v.MigrationStatus == "error" && (migrating || previousMigrationStatus != "error"):
+			return v, v.MigrationStatus, errors.New("The volume migration failed")
+		case v.Status == "retyping":
+			return v, v.Status, nil
+		case v.Status == "maintenance",
+			v.MigrationStatus == "starting",
+			v.MigrationStatus == "migrating",
+			v.MigrationStatus == "completing":
+			migrating = true
+
+			return v, "migrating", nil
+		}
+
+		return v, "done", nil
+	}
+}
+
+// blockStorageVolumeV3WaitForMigration waits for a retype or a migration to
+// finish and enriches a failure with the latest Cinder user message.
+// previousMigrationStatus is the migration_status of the volume before the
+// retype or the migration was requested.
+func blockStorageVolumeV3WaitForMigration(ctx context.Context, client *gophercloud.ServiceClient, volumeID, previousMigrationStatus string, timeout time.Duration) (*blockStorageV3VolumeMigration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"retyping", "migrating"},
+		Target:     []string{"done"},
+		Refresh:    blockStorageVolumeV3MigrationStateRefreshFunc(ctx, client, volumeID, previousMigrationStatus),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	v, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, blockStorageV3ErrorWithMessage(ctx, client, volumeID, err)
+	}
+
+	return v.(*blockStorageV3VolumeMigration), nil
+}
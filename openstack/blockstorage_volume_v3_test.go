@@ -1,11 +1,16 @@
 package openstack
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func blockStorageVolumeV3VolumeFixture() volumes.Volume {
@@ -91,3 +96,79 @@ func TestUnitBlockStorageVolumeV3SchedulerHintsHash(t *testing.T) {
 
 	assert.Equal(t, expectedHashcode, actualHashcode)
 }
+
+func TestUnitBlockStorageVolumeV3MigrationStateRefreshFunc(t *testing.T) {
+	testCases := []struct {
+		status                  string
+		migrationStatus         string
+		previousMigrationStatus string
+		expectedState           string
+		expectedErr             bool
+	}{
+		{"retyping", "", "", "retyping", false},
+		{"available", "migrating", "", "migrating", false},
+		{"in-use", "completing", "", "migrating", false},
+		{"maintenance", "", "", "migrating", false},
+		{"available", "error", "", "error", true},
+		{"available", "error", "success", "error", true},
+		{"available", "error", "error", "done", false},
+		{"error", "", "", "error", true},
+		{"in-use", "success", "", "done", false},
+		{"available", "", "", "done", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.status+"/"+tc.migrationStatus+"/"+tc.previousMigrationStatus, func(t *testing.T) {
+			fakeServer := th.SetupHTTP()
+			defer fakeServer.Teardown()
+
+			fakeServer.Mux.HandleFunc("/volumes/289da7f8-6440-407c-9fb4-7db01ec49164", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Add("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"volume": {"id": "289da7f8-6440-407c-9fb4-7db01ec49164", "status": %q, "migration_status": %q, "os-vol-host-attr:host": "cinder@lvmdriver-1#lvmdriver-1"}}`,
+					tc.status, tc.migrationStatus)
+			})
+
+			client := thclient.ServiceClient(fakeServer)
+
+			v, state, err := blockStorageVolumeV3MigrationStateRefreshFunc(t.Context(), client, "289da7f8-6440-407c-9fb4-7db01ec49164", tc.previousMigrationStatus)()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedState, state)
+			assert.Equal(t, "cinder@lvmdriver-1#lvmdriver-1", v.(*blockStorageV3VolumeMigration).Host)
+		})
+	}
+}
+
+func TestUnitBlockStorageVolumeV3MigrationStateRefreshFuncPreviousError(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	// A volume with a failed earlier migration, which fails again.
+	migrationStatuses := []string{"error", "migrating", "error"}
+
+	fakeServer.Mux.HandleFunc("/volumes/289da7f8-6440-407c-9fb4-7db01ec49164", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"volume": {"id": "289da7f8-6440-407c-9fb4-7db01ec49164", "status": "available", "migration_status": %q}}`,
+			migrationStatuses[0])
+
+		migrationStatuses = migrationStatuses[1:]
+	})
+
+	refresh := blockStorageVolumeV3MigrationStateRefreshFunc(t.Context(), thclient.ServiceClient(fakeServer), "289da7f8-6440-407c-9fb4-7db01ec49164", "error")
+
+	_, state, err := refresh()
+	require.NoError(t, err)
+	assert.Equal(t, "done", state)
+
+	_, state, err = refresh()
+	require.NoError(t, err)
+	assert.Equal(t, "migrating", state)
+
+	_, state, err = refresh()
+	require.Error(t, err)
+	assert.Equal(t, "error", state)
+}
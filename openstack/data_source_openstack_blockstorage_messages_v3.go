@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-provider-openstack/utils/v2/hashcode"
+)
+
+func dataSourceBlockStorageMessagesV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBlockStorageMessagesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"VOLUME", "VOLUME_SNAPSHOT", "VOLUME_BACKUP", "IMAGE_CACHE", "GROUP",
+				}, false),
+			},
+
+			"event_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"message_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"request_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"messages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageMessagesV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	listOpts := blockStorageV3MessagesListOpts{
+		ResourceUUID: d.Get("resource_id").(string),
+		ResourceType: d.Get("resource_type").(string),
+		EventID:      d.Get("event_id").(string),
+		MessageLevel: d.Get("message_level").(string),
+		RequestID:    d.Get("request_id").(string),
+		Sort:         "created_at:desc",
+	}
+
+	allMessages, err := blockStorageV3ListMessages(ctx, client, listOpts)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_blockstorage_messages_v3: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_messages_v3: %#v", allMessages)
+
+	ids := make([]string, len(allMessages))
+	for i, m := range allMessages {
+		ids[i] = m.ID
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("messages", flattenBlockStorageV3Messages(allMessages))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlockStorageV3MessagesV3_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3MessagesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openstack_blockstorage_messages_v3.messages", "messages.#"),
+					resource.TestCheckResourceAttr("data.openstack_blockstorage_messages_v3.volume", "messages.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBlockStorageV3MessagesConfig = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+data "openstack_blockstorage_messages_v3" "messages" {
+  resource_type = "VOLUME"
+}
+
+data "openstack_blockstorage_messages_v3" "volume" {
+  resource_id = openstack_blockstorage_volume_v3.volume_1.id
+}
+`
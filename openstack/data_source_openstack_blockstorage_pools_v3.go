@@ -0,0 +1,174 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/schedulerstats"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-provider-openstack/utils/v2/hashcode"
+)
+
+func dataSourceBlockStoragePoolsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBlockStoragePoolsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"volume_backend_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"volume_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"volume_backend_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vendor_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"driver_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_capacity_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"free_capacity_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"allocated_capacity_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"provisioned_capacity_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"reserved_percentage": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_over_subscription_ratio": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thin_provisioning_support": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"thick_provisioning_support": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"qos_support": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"multiattach": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"total_volumes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBlockStoragePoolsV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	listOpts := schedulerstats.ListOpts{
+		Detail: true,
+	}
+
+	allPages, err := schedulerstats.List(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_blockstorage_pools_v3: %s", err)
+	}
+
+	allPools, err := schedulerstats.ExtractStoragePools(allPages)
+	if err != nil {
+		return diag.Errorf("Error extracting openstack_blockstorage_pools_v3 from response: %s", err)
+	}
+
+	var extraSpecs map[string]string
+
+	if v := d.Get("volume_type").(string); v != "" {
+		extraSpecs, err = blockStorageV3VolumeTypeExtraSpecs(ctx, client, v)
+		if err != nil {
+			return diag.Errorf("Error retrieving extra specs for openstack_blockstorage_pools_v3 volume type %s: %s", v, err)
+		}
+	}
+
+	backend := d.Get("volume_backend_name").(string)
+
+	var pools []schedulerstats.StoragePool
+
+	for _, p := range allPools {
+		if backend != "" && p.Capabilities.VolumeBackendName != backend {
+			continue
+		}
+
+		if !blockStorageV3PoolMatchesExtraSpecs(p, extraSpecs) {
+			continue
+		}
+
+		pools = append(pools, p)
+	}
+
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].Name < pools[j].Name
+	})
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_pools_v3: %#v", pools)
+
+	names := make([]string, len(pools))
+	for i, p := range pools {
+		names[i] = p.Name
+	}
+
+	d.SetId(hashcode.Strings(names))
+	d.Set("pools", flattenBlockStorageV3Pools(pools))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
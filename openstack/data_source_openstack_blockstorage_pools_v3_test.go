@@ -0,0 +1,44 @@
+package openstack
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlockStorageV3PoolsV3_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3PoolsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.openstack_blockstorage_pools_v3.pools", "pools.#", regexp.MustCompile(`[1-9]\d*`)),
+					resource.TestCheckResourceAttrSet("data.openstack_blockstorage_pools_v3.pools", "pools.0.name"),
+					resource.TestCheckResourceAttrSet("data.openstack_blockstorage_pools_v3.pools", "pools.0.volume_backend_name"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3PoolsConfigBackend,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openstack_blockstorage_pools_v3.pools", "pools.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBlockStorageV3PoolsConfig = `
+data "openstack_blockstorage_pools_v3" "pools" {}
+`
+
+const testAccBlockStorageV3PoolsConfigBackend = `
+data "openstack_blockstorage_pools_v3" "pools" {
+  volume_backend_name = "non-existent-backend"
+}
+`
@@ -0,0 +1,80 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDNSBlacklistV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSBlacklistV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSBlacklistV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	// Designate treats "*" in filters as a wildcard, which is common in
+	// regular expressions, so the patterns are compared locally.
+	blacklists, err := dnsBlacklistV2List(ctx, dnsClient, dnsBlacklistV2ListOpts{})
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_dns_blacklist_v2: %s", err)
+	}
+
+	pattern := d.Get("pattern").(string)
+
+	var allBlacklists []dnsBlacklistV2
+
+	for _, v := range blacklists {
+		if v.Pattern == pattern {
+			allBlacklists = append(allBlacklists, v)
+		}
+	}
+
+	if len(allBlacklists) < 1 {
+		return diag.Errorf("Your query returned no openstack_dns_blacklist_v2. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allBlacklists) > 1 {
+		return diag.Errorf("Your query returned more than one openstack_dns_blacklist_v2." +
+			" Please try a more specific search criteria")
+	}
+
+	blacklist := allBlacklists[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_blacklist_v2 %s: %#v", blacklist.ID, blacklist)
+
+	d.SetId(blacklist.ID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("pattern", blacklist.Pattern)
+	d.Set("description", blacklist.Description)
+
+	return nil
+}
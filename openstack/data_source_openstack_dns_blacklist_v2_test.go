@@ -0,0 +1,43 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSV2BlacklistDataSource_basic(t *testing.T) {
+	pattern := fmt.Sprintf(`^([A-Za-z0-9_\\-]+\\.)*acpttest%s\\.com\\.$`, strings.ToLower(acctest.RandString(5)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2BlacklistDataSourceBasic(pattern),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_blacklist_v2.blacklist_1", "id", "openstack_dns_blacklist_v2.blacklist_1", "id"),
+					resource.TestCheckResourceAttr("data.openstack_dns_blacklist_v2.blacklist_1", "description", "a blacklist"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSV2BlacklistDataSourceBasic(pattern string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "openstack_dns_blacklist_v2" "blacklist_1" {
+			pattern = openstack_dns_blacklist_v2.blacklist_1.pattern
+		}
+	`, testAccDNSV2BlacklistBasic(pattern, "a blacklist"))
+}
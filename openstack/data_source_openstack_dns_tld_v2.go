@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDNSTLDV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSTLDV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSTLDV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	listOpts := dnsTLDV2ListOpts{
+		Name: d.Get("name").(string),
+	}
+
+	allTLDs, err := dnsTLDV2List(ctx, dnsClient, listOpts)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_dns_tld_v2: %s", err)
+	}
+
+	if len(allTLDs) < 1 {
+		return diag.Errorf("Your query returned no openstack_dns_tld_v2. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allTLDs) > 1 {
+		return diag.Errorf("Your query returned more than one openstack_dns_tld_v2." +
+			" Please try a more specific search criteria")
+	}
+
+	tld := allTLDs[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_tld_v2 %s: %#v", tld.ID, tld)
+
+	d.SetId(tld.ID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", tld.Name)
+	d.Set("description", tld.Description)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSV2TLDDataSource_basic(t *testing.T) {
+	tldName := "acpttest" + strings.ToLower(acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TLDDataSourceBasic(tldName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_tld_v2.tld_1", "id", "openstack_dns_tld_v2.tld_1", "id"),
+					resource.TestCheckResourceAttr("data.openstack_dns_tld_v2.tld_1", "description", "a TLD"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSV2TLDDataSourceBasic(tldName string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "openstack_dns_tld_v2" "tld_1" {
+			name = openstack_dns_tld_v2.tld_1.name
+		}
+	`, testAccDNSV2TLDBasic(tldName, "a TLD"))
+}
@@ -0,0 +1,108 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/tsigkeys"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDNSTSIGKeyV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSTSIGKeyV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dnsTSIGKeyV2Algorithms, false),
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ZONE", "POOL",
+				}, false),
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSTSIGKeyV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	listOpts := tsigkeys.ListOpts{
+		Name:      d.Get("name").(string),
+		Algorithm: d.Get("algorithm").(string),
+		Scope:     d.Get("scope").(string),
+	}
+
+	allPages, err := tsigkeys.List(dnsClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_dns_tsigkey_v2: %s", err)
+	}
+
+	allKeys, err := tsigkeys.ExtractTSIGKeys(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to extract openstack_dns_tsigkey_v2: %s", err)
+	}
+
+	if len(allKeys) < 1 {
+		return diag.Errorf("Your query returned no openstack_dns_tsigkey_v2. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allKeys) > 1 {
+		return diag.Errorf("Your query returned more than one openstack_dns_tsigkey_v2." +
+			" Please try a more specific search criteria")
+	}
+
+	key := allKeys[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_tsigkey_v2 %s: name %s, algorithm %s, scope %s, resource_id %s",
+		key.ID, key.Name, key.Algorithm, key.Scope, key.ResourceID)
+
+	d.SetId(key.ID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", key.Name)
+	d.Set("algorithm", key.Algorithm)
+	d.Set("scope", key.Scope)
+	d.Set("resource_id", key.ResourceID)
+	d.Set("secret", key.Secret)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSV2TSIGKeyDataSource_basic(t *testing.T) {
+	zoneName := randomZoneName()
+	keyName := "ACPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TSIGKeyDataSourceBasic(zoneName, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_tsigkey_v2.key_1", "id", "openstack_dns_tsigkey_v2.key_1", "id"),
+					resource.TestCheckResourceAttr("data.openstack_dns_tsigkey_v2.key_1", "algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr("data.openstack_dns_tsigkey_v2.key_1", "scope", "ZONE"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_tsigkey_v2.key_1", "resource_id", "openstack_dns_zone_v2.zone_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSV2TSIGKeyDataSourceBasic(zoneName, keyName string) string {
+	return fmt.Sprintf(`
+		%s
+
+		data "openstack_dns_tsigkey_v2" "key_1" {
+			name = openstack_dns_tsigkey_v2.key_1.name
+		}
+	`, testAccDNSV2TSIGKeyBasic(zoneName, keyName, "hmac-sha256"))
+}
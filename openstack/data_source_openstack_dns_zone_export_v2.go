@@ -0,0 +1,89 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDNSZoneExportV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSZoneExportV2Read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSZoneExportV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+
+	task, err := dnsZoneExportV2Create(ctx, dnsClient, zoneID)
+	if err != nil {
+		return diag.Errorf("Error exporting openstack_dns_zone_v2 %s: %s", zoneID, err)
+	}
+
+	log.Printf("[DEBUG] Created export task %s for openstack_dns_zone_v2 %s", task.ID, zoneID)
+
+	// The export task is only needed to download the zone file.
+	defer func() {
+		if err := dnsZoneExportV2Delete(ctx, dnsClient, task.ID); err != nil {
+			log.Printf("[DEBUG] Unable to delete export task %s for openstack_dns_zone_v2 %s: %s", task.ID, zoneID, err)
+		}
+	}()
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"COMPLETE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsZoneTaskV2RefreshFunc(ctx, dnsClient, task.ID, dnsZoneExportV2Get),
+		Timeout:    d.Timeout(schema.TimeoutRead),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for export task %s of openstack_dns_zone_v2 %s to complete: %s", task.ID, zoneID, err)
+	}
+
+	zoneFile, err := dnsZoneExportV2Download(ctx, dnsClient, task.ID)
+	if err != nil {
+		return diag.Errorf("Error downloading export of openstack_dns_zone_v2 %s: %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("zone_file", zoneFile)
+
+	return nil
+}
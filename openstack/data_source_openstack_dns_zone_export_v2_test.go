@@ -0,0 +1,64 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSV2ZoneExportDataSource_basic(t *testing.T) {
+	zoneName := strings.ToLower(fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2ZoneDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackDNSZoneExportV2DataSource(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_zone_export_v2.export_1", "zone_id",
+						"openstack_dns_zone_v2.zone_1", "id"),
+					resource.TestMatchResourceAttr(
+						"data.openstack_dns_zone_export_v2.export_1", "zone_file",
+						regexp.MustCompile(regexp.QuoteMeta("$ORIGIN "+zoneName))),
+					resource.TestMatchResourceAttr(
+						"data.openstack_dns_zone_export_v2.export_1", "zone_file",
+						regexp.MustCompile(`192\.0\.2\.10`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackDNSZoneExportV2DataSource(zoneName string) string {
+	return fmt.Sprintf(`
+resource "openstack_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email@example.com"
+  ttl   = 3000
+}
+
+resource "openstack_dns_recordset_v2" "recordset_1" {
+  zone_id = openstack_dns_zone_v2.zone_1.id
+  name    = "www.%[1]s"
+  type    = "A"
+  records = ["192.0.2.10"]
+}
+
+data "openstack_dns_zone_export_v2" "export_1" {
+  zone_id = openstack_dns_zone_v2.zone_1.id
+
+  depends_on = [openstack_dns_recordset_v2.recordset_1]
+}
+`, zoneName)
+}
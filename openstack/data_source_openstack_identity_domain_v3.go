@@ -0,0 +1,97 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIdentityDomainV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityDomainV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+// dataSourceIdentityDomainV3Read performs the domain lookup.
+func dataSourceIdentityDomainV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	listOpts := domains.ListOpts{
+		Name: d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_domain_v3 list options: %#v", listOpts)
+
+	allPages, err := domains.List(identityClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_identity_domain_v3: %s", err)
+	}
+
+	var allDomains []identityDomainV3
+
+	err = allPages.(domains.DomainPage).ExtractIntoSlicePtr(&allDomains, "domains")
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_identity_domain_v3: %s", err)
+	}
+
+	if len(allDomains) < 1 {
+		return diag.Errorf("Your openstack_identity_domain_v3 query returned no results. " +
+			"Please change your search criteria and try again")
+	}
+
+	if len(allDomains) > 1 {
+		return diag.Errorf("Your openstack_identity_domain_v3 query returned more than one result")
+	}
+
+	domain := allDomains[0]
+
+	log.Printf("[DEBUG] openstack_identity_domain_v3 details: %#v", domain)
+
+	d.SetId(domain.ID)
+	d.Set("name", domain.Name)
+	d.Set("description", domain.Description)
+	d.Set("enabled", domain.Enabled)
+	d.Set("tags", domain.Tags)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/amphorae"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-provider-openstack/utils/v2/hashcode"
+)
+
+func dataSourceLBAmphoraeV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBAmphoraeV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"MASTER", "BACKUP", "STANDALONE",
+				}, false),
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"amphorae": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"loadbalancer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compute_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lb_network_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrrp_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrrp_port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cached_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cert_busy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cert_expiration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBAmphoraeV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	listOpts := amphorae.ListOpts{
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		Role:           d.Get("role").(string),
+		Status:         d.Get("status").(string),
+		ImageID:        d.Get("image_id").(string),
+	}
+
+	allPages, err := amphorae.List(lbClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_lb_amphorae_v2: %s", err)
+	}
+
+	allAmphorae, err := amphorae.ExtractAmphorae(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_lb_amphorae_v2: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_amphorae_v2: %#v", allAmphorae)
+
+	ids := make([]string, len(allAmphorae))
+	for i, a := range allAmphorae {
+		ids[i] = a.ID
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("amphorae", flattenLBAmphoraeV2(allAmphorae))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
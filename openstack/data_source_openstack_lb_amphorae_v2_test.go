@@ -0,0 +1,70 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceLBV2Amphorae_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLBV2AmphoraeConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.#"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.compute_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.lb_network_ip"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.ha_ip",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "vip_address"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.status", "ALLOCATED"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.cert_expiration"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceLBV2AmphoraeConfigBasic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+data "openstack_lb_amphorae_v2" "amphorae_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+`
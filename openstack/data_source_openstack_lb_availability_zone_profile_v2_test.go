@@ -0,0 +1,39 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2AvailabilityZoneProfileDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2AvailabilityZoneProfileDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2AvailabilityZoneProfileDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_availability_zone_profile_v2.azp_1", "id",
+						"openstack_lb_availability_zone_profile_v2.azp_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availability_zone_profile_v2.azp_1", "provider_name", "amphora"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availability_zone_profile_v2.azp_1", "availability_zone_data", `{"compute_zone":"nova"}`),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBV2AvailabilityZoneProfileDataSourceBasic = testAccCheckLbV2AvailabilityZoneProfile + `
+data "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name = openstack_lb_availability_zone_profile_v2.azp_1.name
+}
+`
@@ -0,0 +1,112 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBAvailabilityZoneV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBAvailabilityZoneV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"availability_zone_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBAvailabilityZoneV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	profileID := d.Get("availability_zone_profile_id").(string)
+
+	if name != "" && profileID == "" {
+		az, err := lbAvailabilityZoneV2Get(ctx, lbClient, name)
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return diag.Errorf("No availability zone found")
+			}
+
+			return diag.Errorf("Unable to retrieve OpenStack %s loadbalancer availability zone: %s", name, err)
+		}
+
+		dataSourceLBAvailabilityZoneV2Attributes(d, az)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	opts := lbAvailabilityZoneV2ListOpts{
+		Name:                      name,
+		AvailabilityZoneProfileID: profileID,
+	}
+
+	allAvailabilityZones, err := lbAvailabilityZoneV2List(ctx, lbClient, opts)
+	if err != nil {
+		return diag.Errorf("Unable to query OpenStack loadbalancer availability zones: %s", err)
+	}
+
+	if len(allAvailabilityZones) < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allAvailabilityZones) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allAvailabilityZones)
+
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	dataSourceLBAvailabilityZoneV2Attributes(d, &allAvailabilityZones[0])
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func dataSourceLBAvailabilityZoneV2Attributes(d *schema.ResourceData, az *lbAvailabilityZoneV2) {
+	log.Printf("[DEBUG] Retrieved openstack_lb_availability_zone_v2 %s: %#v", az.Name, az)
+
+	d.SetId(az.Name)
+	d.Set("name", az.Name)
+	d.Set("description", az.Description)
+	d.Set("availability_zone_profile_id", az.AvailabilityZoneProfileID)
+	d.Set("enabled", az.Enabled)
+}
@@ -0,0 +1,41 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2AvailabilityZoneDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2AvailabilityZoneDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2AvailabilityZoneDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availability_zone_v2.az_1", "id", "az_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availability_zone_v2.az_1", "description", "az_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_availability_zone_v2.az_1", "enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_availability_zone_v2.az_1", "availability_zone_profile_id",
+						"openstack_lb_availability_zone_profile_v2.azp_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBV2AvailabilityZoneDataSourceBasic = testAccCheckLbV2AvailabilityZone + `
+data "openstack_lb_availability_zone_v2" "az_1" {
+  name = openstack_lb_availability_zone_v2.az_1.name
+}
+`
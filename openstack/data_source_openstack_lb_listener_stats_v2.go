@@ -0,0 +1,80 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBListenerStatsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBListenerStatsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"active_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_in": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_out": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"request_errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBListenerStatsV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	listenerID := d.Get("listener_id").(string)
+
+	stats, err := listeners.GetStats(ctx, lbClient, listenerID).Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve OpenStack listener %s stats: %s", listenerID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_listener_stats_v2 %s: %#v", listenerID, stats)
+
+	d.SetId(listenerID)
+
+	lbV2SetStats(d, (*loadbalancers.Stats)(stats))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
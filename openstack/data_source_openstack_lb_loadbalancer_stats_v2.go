@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBLoadbalancerStatsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBLoadbalancerStatsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"active_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_in": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_out": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"request_errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBLoadbalancerStatsV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+
+	stats, err := loadbalancers.GetStats(ctx, lbClient, lbID).Extract()
+	if err != nil {
+		return diag.Errorf("Unable to retrieve OpenStack loadbalancer %s stats: %s", lbID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_loadbalancer_stats_v2 %s: %#v", lbID, stats)
+
+	d.SetId(lbID)
+
+	lbV2SetStats(d, stats)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
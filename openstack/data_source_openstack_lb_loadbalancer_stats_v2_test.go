@@ -0,0 +1,67 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceLBV2LoadBalancerStats_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLbV2LoadBalancerStatsConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "bytes_in"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "bytes_out"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "active_connections"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "total_connections"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "request_errors"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceLbV2LoadBalancerStatsConfigBasic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+data "openstack_lb_loadbalancer_stats_v2" "stats_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+`
@@ -0,0 +1,183 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBLoadbalancerStatusV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBLoadbalancerStatusV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"listener": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provisioning_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operating_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pool": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"provisioning_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"operating_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"healthmonitor": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"provisioning_status": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"operating_status": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"member": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"address": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"protocol_port": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"provisioning_status": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"operating_status": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBLoadbalancerStatusV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+
+	tree, err := lbV2GetStatusTree(ctx, lbClient, lbID)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve OpenStack loadbalancer %s status tree: %s", lbID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_loadbalancer_status_v2 %s: %#v", lbID, tree)
+
+	d.SetId(lbID)
+
+	d.Set("name", tree.Name)
+	d.Set("provisioning_status", tree.ProvisioningStatus)
+	d.Set("operating_status", tree.OperatingStatus)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("listener", flattenLBStatusTreeListenersV2(tree.Listeners)); err != nil {
+		return diag.Errorf("Unable to set openstack_lb_loadbalancer_status_v2 listener: %s", err)
+	}
+
+	return nil
+}
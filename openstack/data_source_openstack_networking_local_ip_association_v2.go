@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkingLocalIPAssociationV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingLocalIPAssociationV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"local_ip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"fixed_port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"fixed_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"local_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingLocalIPAssociationV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	localIPID := d.Get("local_ip_id").(string)
+	fixedPortID := d.Get("fixed_port_id").(string)
+
+	association, err := networkingLocalIPAssociationV2Get(ctx, networkingClient, localIPID, fixedPortID)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_networking_local_ip_association_v2 of local IP %s and port %s: %s", localIPID, fixedPortID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_local_ip_association_v2 %s/%s: %#v", localIPID, fixedPortID, association)
+
+	d.SetId(fmt.Sprintf("%s/%s", localIPID, association.FixedPortID))
+	d.Set("fixed_ip", association.FixedIP)
+	d.Set("local_ip_address", association.LocalIPAddress)
+	d.Set("host", association.Host)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
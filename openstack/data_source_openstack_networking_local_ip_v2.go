@@ -0,0 +1,137 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkingLocalIPV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingLocalIPV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"local_ip_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"local_port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"local_ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ip_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"revision_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingLocalIPV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var localIP *networkingLocalIPV2
+
+	if id := d.Get("local_ip_id").(string); id != "" {
+		localIP, err = networkingLocalIPV2Get(ctx, networkingClient, id)
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_networking_local_ip_v2 %s: %s", id, err)
+		}
+	} else {
+		listOpts := networkingLocalIPV2ListOpts{
+			Name:           d.Get("name").(string),
+			Description:    d.Get("description").(string),
+			ProjectID:      d.Get("project_id").(string),
+			LocalPortID:    d.Get("local_port_id").(string),
+			NetworkID:      d.Get("network_id").(string),
+			LocalIPAddress: d.Get("local_ip_address").(string),
+			IPMode:         d.Get("ip_mode").(string),
+		}
+
+		allLocalIPs, err := networkingLocalIPV2List(ctx, networkingClient, listOpts)
+		if err != nil {
+			return diag.Errorf("Unable to list openstack_networking_local_ip_v2: %s", err)
+		}
+
+		if len(allLocalIPs) < 1 {
+			return diag.Errorf("Your query returned no openstack_networking_local_ip_v2. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(allLocalIPs) > 1 {
+			return diag.Errorf("Your query returned more than one openstack_networking_local_ip_v2." +
+				" Please try a more specific search criteria")
+		}
+
+		localIP = &allLocalIPs[0]
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_local_ip_v2 %s: %#v", localIP.ID, localIP)
+
+	d.SetId(localIP.ID)
+	d.Set("local_ip_id", localIP.ID)
+	d.Set("name", localIP.Name)
+	d.Set("description", localIP.Description)
+	d.Set("network_id", localIP.NetworkID)
+	d.Set("local_port_id", localIP.LocalPortID)
+	d.Set("local_ip_address", localIP.LocalIPAddress)
+	d.Set("ip_mode", localIP.IPMode)
+	d.Set("project_id", localIP.ProjectID)
+	d.Set("revision_number", localIP.RevisionNumber)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
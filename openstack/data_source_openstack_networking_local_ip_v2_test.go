@@ -0,0 +1,47 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkingV2LocalIPDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLocalIP(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_local_ip_v2.local_ip_1", "id",
+						"openstack_networking_local_ip_v2.local_ip_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_local_ip_v2.local_ip_1", "local_ip_address", "192.168.199.100"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_local_ip_association_v2.association_1", "fixed_ip",
+						"openstack_networking_local_ip_association_v2.association_1", "fixed_ip"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNetworkingV2LocalIPDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "openstack_networking_local_ip_v2" "local_ip_1" {
+  name = openstack_networking_local_ip_v2.local_ip_1.name
+}
+
+data "openstack_networking_local_ip_association_v2" "association_1" {
+  local_ip_id   = openstack_networking_local_ip_association_v2.association_1.local_ip_id
+  fixed_port_id = openstack_networking_local_ip_association_v2.association_1.fixed_port_id
+}
+`, testAccNetworkingV2LocalIPBasic)
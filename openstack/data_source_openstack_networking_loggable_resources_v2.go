@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-provider-openstack/utils/v2/hashcode"
+)
+
+func dataSourceNetworkingLoggableResourcesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingLoggableResourcesV2Read,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+			},
+
+			"types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingLoggableResourcesV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	types, err := networkingLogV2ListLoggableResources(ctx, networkingClient)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_networking_loggable_resources_v2: %s", err)
+	}
+
+	sort.Strings(types)
+
+	d.SetId(hashcode.Strings(types))
+	d.Set("types", types)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkingNDPProxyV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingNDPProxyV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ndp_proxy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"revision_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingNDPProxyV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var proxy *networkingNDPProxyV2
+
+	if id := d.Get("ndp_proxy_id").(string); id != "" {
+		proxy, err = networkingNDPProxyV2Get(ctx, networkingClient, id)
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_networking_ndp_proxy_v2 %s: %s", id, err)
+		}
+	} else {
+		listOpts := networkingNDPProxyV2ListOpts{
+			Name:      d.Get("name").(string),
+			ProjectID: d.Get("project_id").(string),
+			RouterID:  d.Get("router_id").(string),
+			PortID:    d.Get("port_id").(string),
+			IPAddress: d.Get("ip_address").(string),
+		}
+
+		allProxies, err := networkingNDPProxyV2List(ctx, networkingClient, listOpts)
+		if err != nil {
+			return diag.Errorf("Unable to list openstack_networking_ndp_proxy_v2: %s", err)
+		}
+
+		if len(allProxies) < 1 {
+			return diag.Errorf("Your query returned no openstack_networking_ndp_proxy_v2. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(allProxies) > 1 {
+			return diag.Errorf("Your query returned more than one openstack_networking_ndp_proxy_v2." +
+				" Please try a more specific search criteria")
+		}
+
+		proxy = &allProxies[0]
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_ndp_proxy_v2 %s: %#v", proxy.ID, proxy)
+
+	d.SetId(proxy.ID)
+	d.Set("ndp_proxy_id", proxy.ID)
+	d.Set("name", proxy.Name)
+	d.Set("description", proxy.Description)
+	d.Set("router_id", proxy.RouterID)
+	d.Set("port_id", proxy.PortID)
+	d.Set("ip_address", proxy.IPAddress)
+	d.Set("project_id", proxy.ProjectID)
+	d.Set("revision_number", proxy.RevisionNumber)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
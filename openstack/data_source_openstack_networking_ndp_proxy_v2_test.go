@@ -0,0 +1,43 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkingV2NDPProxyDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckNDPProxy(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2NDPProxyDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_ndp_proxy_v2.proxy_1", "id",
+						"openstack_networking_ndp_proxy_v2.proxy_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_ndp_proxy_v2.proxy_1", "ip_address",
+						"openstack_networking_ndp_proxy_v2.proxy_1", "ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkingV2NDPProxyDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_ndp_proxy_v2" "proxy_1" {
+  router_id = openstack_networking_ndp_proxy_v2.proxy_1.router_id
+  port_id   = openstack_networking_ndp_proxy_v2.proxy_1.port_id
+}
+`, testAccNetworkingV2NDPProxyBasic())
+}
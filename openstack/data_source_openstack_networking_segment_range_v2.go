@@ -0,0 +1,170 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetworkingSegmentRangeV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingSegmentRangeV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"segment_range_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"network_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vlan",
+					"vxlan",
+					"gre",
+					"geneve",
+				}, false),
+			},
+
+			"physical_network": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"minimum": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"maximum": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"shared": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"available": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"used": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"segmentation_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"revision_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingSegmentRangeV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var r *networkingSegmentRangeV2
+
+	if id := d.Get("segment_range_id").(string); id != "" {
+		r, err = networkingSegmentRangeV2Get(ctx, networkingClient, id)
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_networking_segment_range_v2 %s: %s", id, err)
+		}
+	} else {
+		listOpts := networkingSegmentRangeV2ListOpts{
+			Name:            d.Get("name").(string),
+			NetworkType:     d.Get("network_type").(string),
+			PhysicalNetwork: d.Get("physical_network").(string),
+			ProjectID:       d.Get("project_id").(string),
+		}
+
+		allRanges, err := networkingSegmentRangeV2List(ctx, networkingClient, listOpts)
+		if err != nil {
+			return diag.Errorf("Unable to list openstack_networking_segment_range_v2: %s", err)
+		}
+
+		if len(allRanges) < 1 {
+			return diag.Errorf("Your query returned no openstack_networking_segment_range_v2. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(allRanges) > 1 {
+			return diag.Errorf("Your query returned more than one openstack_networking_segment_range_v2." +
+				" Please try a more specific search criteria")
+		}
+
+		r = &allRanges[0]
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_segment_range_v2 %s: %#v", r.ID, r)
+
+	d.SetId(r.ID)
+	d.Set("segment_range_id", r.ID)
+	d.Set("name", r.Name)
+	d.Set("network_type", r.NetworkType)
+	d.Set("physical_network", r.PhysicalNetwork)
+	d.Set("project_id", r.ProjectID)
+	d.Set("minimum", r.Minimum)
+	d.Set("maximum", r.Maximum)
+	d.Set("shared", r.Shared)
+	d.Set("default", r.Default)
+	d.Set("available", r.Available)
+	d.Set("used", flattenNetworkingSegmentRangeV2Used(r.Used))
+	d.Set("revision_number", r.RevisionNumber)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkingV2SegmentRangeDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSegmentRange(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SegmentRangeDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_segment_range_v2.range_1", "id",
+						"openstack_networking_segment_range_v2.range_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_segment_range_v2.range_1", "minimum", "100100"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_segment_range_v2.range_1", "maximum", "100109"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_segment_range_v2.range_1", "available.#", "10"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_segment_range_v2.range_1", "used.#", "0"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_segment_range_v2.range_2", "id",
+						"openstack_networking_segment_range_v2.range_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2SegmentRangeDataSourceBasic = `
+resource "openstack_networking_segment_range_v2" "range_1" {
+  name         = "range_1"
+  network_type = "vxlan"
+  minimum      = 100100
+  maximum      = 100109
+}
+
+data "openstack_networking_segment_range_v2" "range_1" {
+  name         = openstack_networking_segment_range_v2.range_1.name
+  network_type = "vxlan"
+}
+
+data "openstack_networking_segment_range_v2" "range_2" {
+  segment_range_id = openstack_networking_segment_range_v2.range_1.id
+}
+`
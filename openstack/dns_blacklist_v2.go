@@ -0,0 +1,91 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// dnsBlacklistV2 represents a Designate blacklist, a pattern of zone names
+// which can only be created by admins.
+type dnsBlacklistV2 struct {
+	ID          string `json:"id"`
+	Pattern     string `json:"pattern"`
+	Description string `json:"description"`
+}
+
+type dnsBlacklistV2CreateOpts struct {
+	Pattern     string `json:"pattern" required:"true"`
+	Description string `json:"description,omitempty"`
+}
+
+type dnsBlacklistV2UpdateOpts struct {
+	Pattern     *string `json:"pattern,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type dnsBlacklistV2ListOpts struct {
+	Pattern string `q:"pattern"`
+}
+
+func dnsBlacklistV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts dnsBlacklistV2CreateOpts) (*dnsBlacklistV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var res dnsBlacklistV2
+
+	_, err = client.Post(ctx, client.ServiceURL("blacklists"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsBlacklistV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*dnsBlacklistV2, error) {
+	var res dnsBlacklistV2
+
+	_, err := client.Get(ctx, client.ServiceURL("blacklists", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsBlacklistV2List(ctx context.Context, client *gophercloud.ServiceClient, opts dnsBlacklistV2ListOpts) ([]dnsBlacklistV2, error) {
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAllPages[dnsBlacklistV2](ctx, client, client.ServiceURL("blacklists")+query.String(), "blacklists")
+}
+
+func dnsBlacklistV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts dnsBlacklistV2UpdateOpts) (*dnsBlacklistV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var res dnsBlacklistV2
+
+	_, err = client.Patch(ctx, client.ServiceURL("blacklists", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsBlacklistV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("blacklists", id), nil)
+
+	return err
+}
@@ -0,0 +1,37 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitDNSBlacklistV2Update(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/blacklists/blacklist_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodPatch)
+		th.TestJSONRequest(t, r, `{"description": ""}`)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprint(w, `{"id": "blacklist_1", "pattern": "^([A-Za-z0-9_\\-]+\\.)*example\\.com\\.$", "description": null}`)
+	})
+
+	description := ""
+
+	blacklist, err := dnsBlacklistV2Update(t.Context(), thclient.ServiceClient(fakeServer), "blacklist_1", dnsBlacklistV2UpdateOpts{
+		Description: &description,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &dnsBlacklistV2{
+		ID:      "blacklist_1",
+		Pattern: `^([A-Za-z0-9_\-]+\.)*example\.com\.$`,
+	}, blacklist)
+}
@@ -0,0 +1,176 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// dnsFloatingIPPTRV2 represents the PTR record of a floating IP, managed by
+// the Designate /reverse/floatingips API.
+type dnsFloatingIPPTRV2 struct {
+	ID          string `json:"id"`
+	PTRDName    string `json:"ptrdname"`
+	Description string `json:"description"`
+	TTL         int    `json:"ttl"`
+	Address     string `json:"address"`
+	Status      string `json:"status"`
+	Action      string `json:"action"`
+}
+
+type dnsFloatingIPPTRV2SetOpts struct {
+	PTRDName    string `json:"ptrdname"`
+	Description string `json:"description"`
+	TTL         int    `json:"ttl,omitempty"`
+}
+
+// dnsFloatingIPPTRV2ID returns the region-qualified floating IP ID used by
+// the Designate reverse API.
+func dnsFloatingIPPTRV2ID(region, floatingIPID string) string {
+	return fmt.Sprintf("%s:%s", region, floatingIPID)
+}
+
+func parseDNSFloatingIPPTRV2ID(id string) (string, string, error) {
+	region, floatingIPID, ok := strings.Cut(id, ":")
+	if !ok || region == "" || floatingIPID == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected <region>:<floatingip_id>", id)
+	}
+
+	return region, floatingIPID, nil
+}
+
+func dnsFloatingIPPTRV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*dnsFloatingIPPTRV2, error) {
+	var res dnsFloatingIPPTRV2
+
+	_, err := client.Get(ctx, client.ServiceURL("reverse", "floatingips", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsFloatingIPPTRV2Set(ctx context.Context, client *gophercloud.ServiceClient, id string, opts dnsFloatingIPPTRV2SetOpts) (*dnsFloatingIPPTRV2, error) {
+	var res dnsFloatingIPPTRV2
+
+	_, err := client.Patch(ctx, client.ServiceURL("reverse", "floatingips", id), opts, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsFloatingIPPTRV2Unset(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Patch(ctx, client.ServiceURL("reverse", "floatingips", id), map[string]any{"ptrdname": nil}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return err
+}
+
+// dnsFloatingIPPTRV2RefreshFunc returns the status of a floating IP PTR
+// record. An unset PTR record is reported as DELETED.
+func dnsFloatingIPPTRV2RefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		ptr, err := dnsFloatingIPPTRV2Get(ctx, client, id)
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return ptr, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] DNS floating IP PTR %s current status: %s", ptr.ID, ptr.Status)
+
+		switch {
+		case ptr.Status == "ERROR":
+			return ptr, ptr.Status, fmt.Errorf("DNS floating IP PTR %s is in ERROR status", ptr.ID)
+		case ptr.PTRDName == "" && ptr.Status != "PENDING":
+			return ptr, "DELETED", nil
+		}
+
+		return ptr, ptr.Status, nil
+	}
+}
+
+// dnsFloatingIPPTRV2Apply sets the PTR record of a floating IP and, unless
+// disableStatusCheck is set, waits for it to become active.
+func dnsFloatingIPPTRV2Apply(ctx context.Context, client *gophercloud.ServiceClient, id string, opts dnsFloatingIPPTRV2SetOpts, disableStatusCheck bool, timeout time.Duration) error {
+	log.Printf("[DEBUG] Setting DNS floating IP PTR %s with options: %#v", id, opts)
+
+	_, err := dnsFloatingIPPTRV2Set(ctx, client, id, opts)
+	if err != nil {
+		return err
+	}
+
+	if disableStatusCheck {
+		return nil
+	}
+
+	return dnsFloatingIPPTRV2WaitForActive(ctx, client, id, timeout)
+}
+
+// dnsFloatingIPPTRV2WaitForActive waits for the PTR record of a floating IP
+// to become active.
+func dnsFloatingIPPTRV2WaitForActive(ctx context.Context, client *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsFloatingIPPTRV2RefreshFunc(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for DNS floating IP PTR %s to become active: %w", id, err)
+	}
+
+	return nil
+}
+
+// dnsFloatingIPPTRV2Remove unsets the PTR record of a floating IP and, unless
+// disableStatusCheck is set, waits for it to be removed.
+func dnsFloatingIPPTRV2Remove(ctx context.Context, client *gophercloud.ServiceClient, id string, disableStatusCheck bool, timeout time.Duration) error {
+	log.Printf("[DEBUG] Unsetting DNS floating IP PTR %s", id)
+
+	err := dnsFloatingIPPTRV2Unset(ctx, client, id)
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if disableStatusCheck {
+		return nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"DELETED"},
+		Pending:    []string{"ACTIVE", "PENDING"},
+		Refresh:    dnsFloatingIPPTRV2RefreshFunc(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for DNS floating IP PTR %s to be unset: %w", id, err)
+	}
+
+	return nil
+}
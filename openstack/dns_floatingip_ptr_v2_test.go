@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitParseDNSFloatingIPPTRV2ID(t *testing.T) {
+	region, floatingIPID, err := parseDNSFloatingIPPTRV2ID("RegionOne:fip_1")
+	require.NoError(t, err)
+	assert.Equal(t, "RegionOne", region)
+	assert.Equal(t, "fip_1", floatingIPID)
+
+	_, _, err = parseDNSFloatingIPPTRV2ID("fip_1")
+	assert.Error(t, err)
+
+	_, _, err = parseDNSFloatingIPPTRV2ID(":fip_1")
+	assert.Error(t, err)
+}
+
+func TestUnitDNSFloatingIPPTRV2Set(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/reverse/floatingips/RegionOne:fip_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodPatch)
+		th.TestJSONRequest(t, r, `{"ptrdname": "mail.example.com.", "description": "mail server", "ttl": 600}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprint(w, `{
+  "id": "RegionOne:fip_1",
+  "ptrdname": "mail.example.com.",
+  "description": "mail server",
+  "ttl": 600,
+  "address": "192.0.2.10",
+  "status": "PENDING",
+  "action": "CREATE"
+}`)
+	})
+
+	ptr, err := dnsFloatingIPPTRV2Set(t.Context(), thclient.ServiceClient(fakeServer), "RegionOne:fip_1", dnsFloatingIPPTRV2SetOpts{
+		PTRDName:    "mail.example.com.",
+		Description: "mail server",
+		TTL:         600,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &dnsFloatingIPPTRV2{
+		ID:          "RegionOne:fip_1",
+		PTRDName:    "mail.example.com.",
+		Description: "mail server",
+		TTL:         600,
+		Address:     "192.0.2.10",
+		Status:      "PENDING",
+		Action:      "CREATE",
+	}, ptr)
+}
+
+func TestUnitDNSFloatingIPPTRV2Unset(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/reverse/floatingips/RegionOne:fip_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodPatch)
+		th.TestJSONRequest(t, r, `{"ptrdname": null}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := dnsFloatingIPPTRV2Unset(t.Context(), thclient.ServiceClient(fakeServer), "RegionOne:fip_1")
+	require.NoError(t, err)
+}
+
+func TestUnitDNSFloatingIPPTRV2RefreshFunc(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	responses := map[string]string{
+		"fip_active":  `{"id": "RegionOne:fip_active", "ptrdname": "mail.example.com.", "status": "ACTIVE"}`,
+		"fip_pending": `{"id": "RegionOne:fip_pending", "ptrdname": null, "status": "PENDING", "action": "DELETE"}`,
+		"fip_unset":   `{"id": "RegionOne:fip_unset", "ptrdname": null, "status": "ACTIVE"}`,
+		"fip_error":   `{"id": "RegionOne:fip_error", "ptrdname": "mail.example.com.", "status": "ERROR"}`,
+	}
+
+	for name, body := range responses {
+		fakeServer.Mux.HandleFunc("/reverse/floatingips/RegionOne:"+name, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, http.MethodGet)
+
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprint(w, body)
+		})
+	}
+
+	client := thclient.ServiceClient(fakeServer)
+
+	for name, expected := range map[string]string{
+		"fip_active":  "ACTIVE",
+		"fip_pending": "PENDING",
+		"fip_unset":   "DELETED",
+		"fip_missing": "DELETED",
+	} {
+		_, status, err := dnsFloatingIPPTRV2RefreshFunc(t.Context(), client, "RegionOne:"+name)()
+		require.NoError(t, err, name)
+		assert.Equal(t, expected, status, name)
+	}
+
+	_, _, err := dnsFloatingIPPTRV2RefreshFunc(t.Context(), client, "RegionOne:fip_error")()
+	assert.ErrorContains(t, err, "ERROR status")
+}
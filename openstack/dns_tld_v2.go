@@ -0,0 +1,90 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// dnsTLDV2 represents a Designate top level domain.
+type dnsTLDV2 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type dnsTLDV2CreateOpts struct {
+	Name        string `json:"name" required:"true"`
+	Description string `json:"description,omitempty"`
+}
+
+type dnsTLDV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type dnsTLDV2ListOpts struct {
+	Name string `q:"name"`
+}
+
+func dnsTLDV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts dnsTLDV2CreateOpts) (*dnsTLDV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var res dnsTLDV2
+
+	_, err = client.Post(ctx, client.ServiceURL("tlds"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsTLDV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*dnsTLDV2, error) {
+	var res dnsTLDV2
+
+	_, err := client.Get(ctx, client.ServiceURL("tlds", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsTLDV2List(ctx context.Context, client *gophercloud.ServiceClient, opts dnsTLDV2ListOpts) ([]dnsTLDV2, error) {
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAllPages[dnsTLDV2](ctx, client, client.ServiceURL("tlds")+query.String(), "tlds")
+}
+
+func dnsTLDV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts dnsTLDV2UpdateOpts) (*dnsTLDV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var res dnsTLDV2
+
+	_, err = client.Patch(ctx, client.ServiceURL("tlds", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsTLDV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("tlds", id), nil)
+
+	return err
+}
@@ -0,0 +1,64 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitDNSTLDV2Create(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/tlds", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodPost)
+		th.TestJSONRequest(t, r, `{"name": "com", "description": "a TLD"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `{"id": "tld_1", "name": "com", "description": "a TLD"}`)
+	})
+
+	tld, err := dnsTLDV2Create(t.Context(), thclient.ServiceClient(fakeServer), dnsTLDV2CreateOpts{
+		Name:        "com",
+		Description: "a TLD",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &dnsTLDV2{ID: "tld_1", Name: "com", Description: "a TLD"}, tld)
+}
+
+func TestUnitDNSTLDV2List(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/tlds", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.URL.Query().Get("marker") == "" {
+			th.TestFormValues(t, r, map[string]string{"name": "com"})
+
+			fmt.Fprintf(w, `{"tlds": [{"id": "tld_1", "name": "com", "description": null}], "links": {"next": "%stlds?marker=tld_1&name=com"}}`, fakeServer.Endpoint())
+
+			return
+		}
+
+		th.TestFormValues(t, r, map[string]string{"marker": "tld_1", "name": "com"})
+
+		fmt.Fprint(w, `{"tlds": [{"id": "tld_2", "name": "com", "description": "another TLD"}], "links": {}}`)
+	})
+
+	tlds, err := dnsTLDV2List(t.Context(), thclient.ServiceClient(fakeServer), dnsTLDV2ListOpts{Name: "com"})
+	require.NoError(t, err)
+	assert.Equal(t, []dnsTLDV2{
+		{ID: "tld_1", Name: "com"},
+		{ID: "tld_2", Name: "com", Description: "another TLD"},
+	}, tlds)
+}
@@ -0,0 +1,354 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dnsZoneRecordSetV2 is a recordset as configured in a recordset block of
+// openstack_dns_zone_recordsets_v2.
+type dnsZoneRecordSetV2 struct {
+	Name        string
+	Type        string
+	Description string
+	TTL         int
+	Records     []string
+}
+
+type dnsZoneRecordSetV2Update struct {
+	ID   string
+	Name string
+	Type string
+	Opts recordsets.UpdateOpts
+}
+
+// dnsZoneRecordSetsV2Changes holds the changes needed to bring the
+// recordsets of a zone in line with the configuration.
+type dnsZoneRecordSetsV2Changes struct {
+	Create []dnsZoneRecordSetV2
+	Update []dnsZoneRecordSetV2Update
+	Delete []recordsets.RecordSet
+}
+
+// dnsZoneRecordSetV2Key identifies a recordset within a zone.
+func dnsZoneRecordSetV2Key(name, rsType string) string {
+	return strings.ToLower(name) + "/" + strings.ToUpper(rsType)
+}
+
+// dnsZoneRecordSetV2Authoritative reports whether a recordset type is managed
+// by openstack_dns_zone_recordsets_v2. SOA and NS recordsets are maintained
+// by Designate and are never touched.
+func dnsZoneRecordSetV2Authoritative(rsType string) bool {
+	switch strings.ToUpper(rsType) {
+	case "SOA", "NS":
+		return false
+	}
+
+	return true
+}
+
+// dnsZoneRecordSetV2Ignored reports whether a recordset matches one of the
+// ignore blocks. Names are matched as case-insensitive shell patterns.
+func dnsZoneRecordSetV2Ignored(name, rsType string, ignore []any) bool {
+	for _, v := range ignore {
+		rule := v.(map[string]any)
+
+		if t := rule["type"].(string); t != "" && !strings.EqualFold(t, rsType) {
+			continue
+		}
+
+		matched, err := path.Match(strings.ToLower(rule["name"].(string)), strings.ToLower(name))
+		if err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func dnsZoneRecordSetsV2Validate(allRecordSets []any, ignore []any) error {
+	for _, v := range ignore {
+		pattern := v.(map[string]any)["name"].(string)
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("Invalid ignore name pattern %q: %w", pattern, err)
+		}
+	}
+
+	keys := make(map[string]bool, len(allRecordSets))
+
+	for _, v := range allRecordSets {
+		rs := v.(map[string]any)
+		name := rs["name"].(string)
+		rsType := rs["type"].(string)
+
+		// Unknown values are validated once they are known.
+		if name == "" || rsType == "" {
+			continue
+		}
+
+		if !dnsZoneRecordSetV2Authoritative(rsType) {
+			return fmt.Errorf("Recordset %s %s: %s recordsets can't be managed by openstack_dns_zone_recordsets_v2", name, rsType, rsType)
+		}
+
+		if dnsZoneRecordSetV2Ignored(name, rsType, ignore) {
+			return fmt.Errorf("Recordset %s %s matches an ignore block and can't be managed", name, rsType)
+		}
+
+		key := dnsZoneRecordSetV2Key(name, rsType)
+		if keys[key] {
+			return fmt.Errorf("Duplicate recordset %s %s: name and type must be unique", name, rsType)
+		}
+
+		keys[key] = true
+	}
+
+	return nil
+}
+
+func expandDNSZoneRecordSetsV2(allRecordSets []any) []dnsZoneRecordSetV2 {
+	res := make([]dnsZoneRecordSetV2, 0, len(allRecordSets))
+
+	for _, v := range allRecordSets {
+		rs := v.(map[string]any)
+
+		records := expandToStringSlice(rs["records"].(*schema.Set).List())
+		slices.Sort(records)
+
+		res = append(res, dnsZoneRecordSetV2{
+			Name:        rs["name"].(string),
+			Type:        rs["type"].(string),
+			Description: rs["description"].(string),
+			TTL:         rs["ttl"].(int),
+			Records:     records,
+		})
+	}
+
+	return res
+}
+
+// flattenDNSZoneRecordSetsV2 converts the recordsets of a zone. Configured
+// recordsets without a ttl keep it unset, because they use the TTL of the
+// zone, whatever the TTL of the actual recordset is.
+func flattenDNSZoneRecordSetsV2(allRecordSets []recordsets.RecordSet, configured []dnsZoneRecordSetV2) []map[string]any {
+	zoneTTL := make(map[string]bool, len(configured))
+	for _, rs := range configured {
+		if rs.TTL == 0 {
+			zoneTTL[dnsZoneRecordSetV2Key(rs.Name, rs.Type)] = true
+		}
+	}
+
+	res := make([]map[string]any, 0, len(allRecordSets))
+
+	for _, rs := range allRecordSets {
+		ttl := rs.TTL
+		if zoneTTL[dnsZoneRecordSetV2Key(rs.Name, rs.Type)] {
+			ttl = 0
+		}
+
+		res = append(res, map[string]any{
+			"name":        rs.Name,
+			"type":        rs.Type,
+			"description": rs.Description,
+			"ttl":         ttl,
+			"records":     stringSliceToSet(rs.Records),
+		})
+	}
+
+	return res
+}
+
+// listDNSZoneRecordSetsV2 returns the recordsets of a zone which are managed
+// by openstack_dns_zone_recordsets_v2: SOA, NS and ignored recordsets are
+// filtered out.
+func listDNSZoneRecordSetsV2(ctx context.Context, dnsClient *gophercloud.ServiceClient, zoneID string, ignore []any) ([]recordsets.RecordSet, error) {
+	allPages, err := recordsets.ListByZone(dnsClient, zoneID, nil).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allRecordSets, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]recordsets.RecordSet, 0, len(allRecordSets))
+
+	for _, rs := range allRecordSets {
+		if !dnsZoneRecordSetV2Authoritative(rs.Type) || dnsZoneRecordSetV2Ignored(rs.Name, rs.Type, ignore) {
+			continue
+		}
+
+		res = append(res, rs)
+	}
+
+	return res, nil
+}
+
+// dnsZoneRecordSetsV2Diff computes the changes needed to turn the current
+// recordsets into the desired ones. Recordsets are matched by name and type.
+// A desired TTL of 0 means the TTL of the zone, so the TTL is not updated.
+func dnsZoneRecordSetsV2Diff(desired []dnsZoneRecordSetV2, current []recordsets.RecordSet) dnsZoneRecordSetsV2Changes {
+	var changes dnsZoneRecordSetsV2Changes
+
+	currentByKey := make(map[string]recordsets.RecordSet, len(current))
+	for _, rs := range current {
+		currentByKey[dnsZoneRecordSetV2Key(rs.Name, rs.Type)] = rs
+	}
+
+	desiredKeys := make(map[string]bool, len(desired))
+
+	for _, rs := range desired {
+		key := dnsZoneRecordSetV2Key(rs.Name, rs.Type)
+		desiredKeys[key] = true
+
+		cur, ok := currentByKey[key]
+		if !ok {
+			changes.Create = append(changes.Create, rs)
+
+			continue
+		}
+
+		var (
+			opts    recordsets.UpdateOpts
+			changed bool
+		)
+
+		records := slices.Clone(cur.Records)
+		slices.Sort(records)
+
+		if !slices.Equal(records, rs.Records) {
+			opts.Records = rs.Records
+			changed = true
+		}
+
+		if rs.TTL != 0 && cur.TTL != rs.TTL {
+			ttl := rs.TTL
+			opts.TTL = &ttl
+			changed = true
+		}
+
+		if cur.Description != rs.Description {
+			description := rs.Description
+			opts.Description = &description
+			changed = true
+		}
+
+		if changed {
+			changes.Update = append(changes.Update, dnsZoneRecordSetV2Update{
+				ID:   cur.ID,
+				Name: cur.Name,
+				Type: cur.Type,
+				Opts: opts,
+			})
+		}
+	}
+
+	for _, rs := range current {
+		if !desiredKeys[dnsZoneRecordSetV2Key(rs.Name, rs.Type)] {
+			changes.Delete = append(changes.Delete, rs)
+		}
+	}
+
+	return changes
+}
+
+// applyDNSZoneRecordSetsV2 sends all changes first and then, unless
+// disableStatusCheck is set, waits for every touched recordset to settle.
+// Deletions are sent first so that names can be reused by new recordsets.
+func applyDNSZoneRecordSetsV2(ctx context.Context, dnsClient *gophercloud.ServiceClient, zoneID string, changes dnsZoneRecordSetsV2Changes, disableStatusCheck bool, timeout time.Duration) error {
+	var active, deleted []string
+
+	for _, rs := range changes.Delete {
+		log.Printf("[DEBUG] Deleting recordset %s %s (%s) of openstack_dns_zone_v2 %s", rs.Name, rs.Type, rs.ID, zoneID)
+
+		err := recordsets.Delete(ctx, dnsClient, zoneID, rs.ID).ExtractErr()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				continue
+			}
+
+			return fmt.Errorf("Error deleting recordset %s %s: %w", rs.Name, rs.Type, err)
+		}
+
+		deleted = append(deleted, rs.ID)
+	}
+
+	for _, u := range changes.Update {
+		log.Printf("[DEBUG] Updating recordset %s %s (%s) of openstack_dns_zone_v2 %s with options: %#v", u.Name, u.Type, u.ID, zoneID, u.Opts)
+
+		_, err := recordsets.Update(ctx, dnsClient, zoneID, u.ID, u.Opts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating recordset %s %s: %w", u.Name, u.Type, err)
+		}
+
+		active = append(active, u.ID)
+	}
+
+	for _, rs := range changes.Create {
+		createOpts := recordsets.CreateOpts{
+			Name:        rs.Name,
+			Type:        rs.Type,
+			Description: rs.Description,
+			TTL:         rs.TTL,
+			Records:     rs.Records,
+		}
+
+		log.Printf("[DEBUG] Creating recordset in openstack_dns_zone_v2 %s with options: %#v", zoneID, createOpts)
+
+		n, err := recordsets.Create(ctx, dnsClient, zoneID, createOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error creating recordset %s %s: %w", rs.Name, rs.Type, err)
+		}
+
+		active = append(active, n.ID)
+	}
+
+	if disableStatusCheck {
+		return nil
+	}
+
+	for _, id := range deleted {
+		stateConf := &retry.StateChangeConf{
+			Target:     []string{"DELETED"},
+			Pending:    []string{"ACTIVE", "PENDING"},
+			Refresh:    dnsRecordSetV2RefreshFunc(ctx, dnsClient, zoneID, id),
+			Timeout:    timeout,
+			Delay:      0,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err := stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Error waiting for recordset %s to become deleted: %w", id, err)
+		}
+	}
+
+	for _, id := range active {
+		stateConf := &retry.StateChangeConf{
+			Target:     []string{"ACTIVE"},
+			Pending:    []string{"PENDING"},
+			Refresh:    dnsRecordSetV2RefreshFunc(ctx, dnsClient, zoneID, id),
+			Timeout:    timeout,
+			Delay:      0,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err := stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Error waiting for recordset %s to become active: %w", id, err)
+		}
+	}
+
+	return nil
+}
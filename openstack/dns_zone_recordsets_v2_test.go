@@ -0,0 +1,108 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/recordsets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitDNSZoneRecordSetV2Ignored(t *testing.T) {
+	ignore := []any{
+		map[string]any{"name": "*.k8s.example.com.", "type": ""},
+		map[string]any{"name": "example.com.", "type": "TXT"},
+	}
+
+	assert.True(t, dnsZoneRecordSetV2Ignored("app.K8S.example.com.", "A", ignore))
+	assert.True(t, dnsZoneRecordSetV2Ignored("example.com.", "TXT", ignore))
+	assert.False(t, dnsZoneRecordSetV2Ignored("example.com.", "MX", ignore))
+	assert.False(t, dnsZoneRecordSetV2Ignored("www.example.com.", "A", ignore))
+}
+
+func TestUnitDNSZoneRecordSetsV2Validate(t *testing.T) {
+	recordSet := func(name, rsType string) map[string]any {
+		return map[string]any{"name": name, "type": rsType}
+	}
+	ignore := []any{
+		map[string]any{"name": "*.k8s.example.com.", "type": ""},
+	}
+
+	err := dnsZoneRecordSetsV2Validate([]any{
+		recordSet("www.example.com.", "A"),
+		recordSet("www.example.com.", "AAAA"),
+	}, ignore)
+	assert.NoError(t, err)
+
+	err = dnsZoneRecordSetsV2Validate([]any{
+		recordSet("www.example.com.", "A"),
+		recordSet("WWW.example.com.", "A"),
+	}, nil)
+	assert.ErrorContains(t, err, "Duplicate recordset")
+
+	err = dnsZoneRecordSetsV2Validate([]any{
+		recordSet("example.com.", "NS"),
+	}, nil)
+	assert.ErrorContains(t, err, "NS recordsets can't be managed")
+
+	err = dnsZoneRecordSetsV2Validate([]any{
+		recordSet("app.k8s.example.com.", "A"),
+	}, ignore)
+	assert.ErrorContains(t, err, "matches an ignore block")
+
+	err = dnsZoneRecordSetsV2Validate(nil, []any{
+		map[string]any{"name": "[", "type": ""},
+	})
+	assert.ErrorContains(t, err, "Invalid ignore name pattern")
+}
+
+func TestUnitDNSZoneRecordSetsV2Diff(t *testing.T) {
+	desired := []dnsZoneRecordSetV2{
+		{Name: "www.example.com.", Type: "A", TTL: 3000, Records: []string{"10.0.0.1", "10.0.0.2"}},
+		{Name: "mail.example.com.", Type: "A", Records: []string{"10.0.0.3"}},
+		{Name: "ftp.example.com.", Type: "A", Description: "ftp", Records: []string{"10.0.0.4"}},
+		{Name: "new.example.com.", Type: "CNAME", Records: []string{"www.example.com."}},
+	}
+	current := []recordsets.RecordSet{
+		{ID: "1", Name: "WWW.example.com.", Type: "A", TTL: 3000, Records: []string{"10.0.0.2", "10.0.0.1"}},
+		{ID: "2", Name: "mail.example.com.", Type: "A", TTL: 600, Records: []string{"10.0.0.3"}},
+		{ID: "3", Name: "ftp.example.com.", Type: "A", Records: []string{"10.0.0.5"}},
+		{ID: "4", Name: "stale.example.com.", Type: "A", Records: []string{"10.0.0.6"}},
+	}
+
+	changes := dnsZoneRecordSetsV2Diff(desired, current)
+
+	assert.Equal(t, []dnsZoneRecordSetV2{desired[3]}, changes.Create)
+	assert.Equal(t, []recordsets.RecordSet{current[3]}, changes.Delete)
+
+	description := "ftp"
+	expectedUpdates := []dnsZoneRecordSetV2Update{
+		{
+			ID:   "3",
+			Name: "ftp.example.com.",
+			Type: "A",
+			Opts: recordsets.UpdateOpts{
+				Records:     []string{"10.0.0.4"},
+				Description: &description,
+			},
+		},
+	}
+	assert.Equal(t, expectedUpdates, changes.Update)
+}
+
+func TestUnitFlattenDNSZoneRecordSetsV2(t *testing.T) {
+	current := []recordsets.RecordSet{
+		{ID: "1", Name: "www.example.com.", Type: "A", TTL: 3000, Records: []string{"10.0.0.1"}},
+		{ID: "2", Name: "mail.example.com.", Type: "A", TTL: 600, Records: []string{"10.0.0.3"}},
+	}
+	configured := []dnsZoneRecordSetV2{
+		{Name: "www.example.com.", Type: "A", TTL: 300, Records: []string{"10.0.0.1"}},
+		{Name: "MAIL.example.com.", Type: "A", Records: []string{"10.0.0.3"}},
+	}
+
+	res := flattenDNSZoneRecordSetsV2(current, configured)
+
+	require.Len(t, res, 2)
+	assert.Equal(t, 3000, res[0]["ttl"])
+	assert.Equal(t, 0, res[1]["ttl"])
+}
@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// dnsZoneTaskV2 represents a Designate zone import or export task.
+type dnsZoneTaskV2 struct {
+	ID        string `json:"id"`
+	ZoneID    string `json:"zone_id"`
+	ProjectID string `json:"project_id"`
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+	Location  string `json:"location"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func dnsZoneImportV2Create(ctx context.Context, client *gophercloud.ServiceClient, zoneFile string) (*dnsZoneTaskV2, error) {
+	var res dnsZoneTaskV2
+
+	_, err := client.Post(ctx, client.ServiceURL("zones", "tasks", "imports"), nil, &res, &gophercloud.RequestOpts{
+		RawBody: strings.NewReader(zoneFile),
+		MoreHeaders: map[string]string{
+			"Content-Type": "text/dns",
+		},
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsZoneImportV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*dnsZoneTaskV2, error) {
+	var res dnsZoneTaskV2
+
+	_, err := client.Get(ctx, client.ServiceURL("zones", "tasks", "imports", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsZoneImportV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("zones", "tasks", "imports", id), nil)
+
+	return err
+}
+
+func dnsZoneExportV2Create(ctx context.Context, client *gophercloud.ServiceClient, zoneID string) (*dnsZoneTaskV2, error) {
+	var res dnsZoneTaskV2
+
+	_, err := client.Post(ctx, client.ServiceURL("zones", zoneID, "tasks", "export"), nil, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func dnsZoneExportV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*dnsZoneTaskV2, error) {
+	var res dnsZoneTaskV2
+
+	_, err := client.Get(ctx, client.ServiceURL("zones", "tasks", "exports", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// dnsZoneExportV2Download returns the zone file of a completed export task.
+func dnsZoneExportV2Download(ctx context.Context, client *gophercloud.ServiceClient, id string) (string, error) {
+	resp, err := client.Get(ctx, client.ServiceURL("zones", "tasks", "exports", id, "export"), nil, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{
+			"Accept": "text/dns",
+		},
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func dnsZoneExportV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("zones", "tasks", "exports", id), nil)
+
+	return err
+}
+
+// dnsZoneTaskV2RefreshFunc returns the status of a zone import or export task,
+// which is retrieved with the get function.
+func dnsZoneTaskV2RefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, id string, get func(context.Context, *gophercloud.ServiceClient, string) (*dnsZoneTaskV2, error)) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		task, err := get(ctx, client, id)
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return task, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] DNS zone task %s current status: %s", task.ID, task.Status)
+
+		if task.Status == "ERROR" {
+			return task, task.Status, fmt.Errorf("DNS zone task %s failed: %s", task.ID, task.Message)
+		}
+
+		return task, task.Status, nil
+	}
+}
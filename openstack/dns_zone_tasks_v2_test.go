@@ -0,0 +1,90 @@
+package openstack
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDNSZoneFile = `$ORIGIN example.com.
+example.com. 3600 IN SOA ns1.example.com. admin.example.com. 1 3600 600 86400 3600
+example.com. 3600 IN NS ns1.example.com.
+`
+
+func TestUnitDNSZoneImportV2Create(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/zones/tasks/imports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodPost)
+		th.TestHeader(t, r, "Content-Type", "text/dns")
+
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, testDNSZoneFile, string(b))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprint(w, `{
+  "id": "import_1",
+  "status": "PENDING",
+  "message": null,
+  "zone_id": null,
+  "project_id": "project_1"
+}`)
+	})
+
+	task, err := dnsZoneImportV2Create(t.Context(), thclient.ServiceClient(fakeServer), testDNSZoneFile)
+	require.NoError(t, err)
+	assert.Equal(t, &dnsZoneTaskV2{
+		ID:        "import_1",
+		Status:    "PENDING",
+		ProjectID: "project_1",
+	}, task)
+}
+
+func TestUnitDNSZoneExportV2Download(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/zones/tasks/exports/export_1/export", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+		th.TestHeader(t, r, "Accept", "text/dns")
+
+		w.Header().Add("Content-Type", "text/dns")
+
+		fmt.Fprint(w, testDNSZoneFile)
+	})
+
+	zoneFile, err := dnsZoneExportV2Download(t.Context(), thclient.ServiceClient(fakeServer), "export_1")
+	require.NoError(t, err)
+	assert.Equal(t, testDNSZoneFile, zoneFile)
+}
+
+func TestUnitDNSZoneTaskV2RefreshFunc(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/zones/tasks/imports/import_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprint(w, `{"id": "import_1", "status": "ERROR", "message": "invalid zone file"}`)
+	})
+
+	_, status, err := dnsZoneTaskV2RefreshFunc(t.Context(), thclient.ServiceClient(fakeServer), "import_1", dnsZoneImportV2Get)()
+	assert.Equal(t, "ERROR", status)
+	assert.ErrorContains(t, err, "invalid zone file")
+
+	_, status, err = dnsZoneTaskV2RefreshFunc(t.Context(), thclient.ServiceClient(fakeServer), "import_2", dnsZoneImportV2Get)()
+	require.NoError(t, err)
+	assert.Equal(t, "DELETED", status)
+}
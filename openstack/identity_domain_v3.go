@@ -0,0 +1,78 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
+)
+
+// identityDomainV3 extends domains.Domain with the tags and options, which
+// are not exposed by gophercloud.
+type identityDomainV3 struct {
+	domains.Domain
+	Tags    []string       `json:"tags"`
+	Options map[string]any `json:"options"`
+}
+
+// identityDomainV3CreateOpts adds the tags and options to domains.CreateOpts.
+type identityDomainV3CreateOpts struct {
+	domains.CreateOpts
+	Tags    []string       `json:"tags,omitempty"`
+	Options map[string]any `json:"options,omitempty"`
+}
+
+// ToDomainCreateMap casts a CreateOpts struct to a map.
+func (opts identityDomainV3CreateOpts) ToDomainCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "domain")
+}
+
+// identityDomainV3UpdateOpts adds the tags and options to domains.UpdateOpts.
+type identityDomainV3UpdateOpts struct {
+	domains.UpdateOpts
+	Tags    *[]string      `json:"tags,omitempty"`
+	Options map[string]any `json:"options,omitempty"`
+}
+
+// ToDomainUpdateMap casts an UpdateOpts struct to a map.
+func (opts identityDomainV3UpdateOpts) ToDomainUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "domain")
+}
+
+func identityDomainV3Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*identityDomainV3, error) {
+	var domain identityDomainV3
+
+	err := domains.Get(ctx, client, id).ExtractIntoStructPtr(&domain, "domain")
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain, nil
+}
+
+func expandIdentityDomainV3Options(v []any) map[string]any {
+	options := map[string]any{
+		"immutable": false,
+	}
+
+	if len(v) > 0 && v[0] != nil {
+		options["immutable"] = v[0].(map[string]any)["immutable"].(bool)
+	}
+
+	return options
+}
+
+// flattenIdentityDomainV3Options returns the options block, which is omitted
+// when no option is set, unless it is already configured.
+func flattenIdentityDomainV3Options(options map[string]any, configured bool) []map[string]any {
+	immutable, _ := options["immutable"].(bool)
+	if !immutable && !configured {
+		return nil
+	}
+
+	return []map[string]any{
+		{
+			"immutable": immutable,
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitIdentityDomainV3CreateOpts(t *testing.T) {
+	enabled := true
+	opts := identityDomainV3CreateOpts{
+		CreateOpts: domains.CreateOpts{
+			Name:        "customer_1",
+			Description: "a domain",
+			Enabled:     &enabled,
+		},
+		Tags:    []string{"foo"},
+		Options: expandIdentityDomainV3Options([]any{map[string]any{"immutable": true}}),
+	}
+
+	b, err := opts.ToDomainCreateMap()
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"domain": map[string]any{
+			"name":        "customer_1",
+			"description": "a domain",
+			"enabled":     true,
+			"tags":        []any{"foo"},
+			"options": map[string]any{
+				"immutable": true,
+			},
+		},
+	}
+	assert.Equal(t, expected, b)
+}
+
+func TestUnitFlattenIdentityDomainV3Options(t *testing.T) {
+	assert.Nil(t, flattenIdentityDomainV3Options(map[string]any{}, false))
+	assert.Nil(t, flattenIdentityDomainV3Options(map[string]any{"immutable": false}, false))
+
+	assert.Equal(t, []map[string]any{{"immutable": false}},
+		flattenIdentityDomainV3Options(map[string]any{}, true))
+	assert.Equal(t, []map[string]any{{"immutable": true}},
+		flattenIdentityDomainV3Options(map[string]any{"immutable": true}, false))
+}
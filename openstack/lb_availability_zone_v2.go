@@ -0,0 +1,241 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// lbAvailabilityZoneProfileV2 represents an Octavia availability zone profile.
+type lbAvailabilityZoneProfileV2 struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	ProviderName         string `json:"provider_name"`
+	AvailabilityZoneData string `json:"availability_zone_data"`
+}
+
+type lbAvailabilityZoneProfileV2CreateOpts struct {
+	Name                 string `json:"name" required:"true"`
+	ProviderName         string `json:"provider_name" required:"true"`
+	AvailabilityZoneData string `json:"availability_zone_data" required:"true"`
+}
+
+type lbAvailabilityZoneProfileV2UpdateOpts struct {
+	Name                 *string `json:"name,omitempty"`
+	ProviderName         *string `json:"provider_name,omitempty"`
+	AvailabilityZoneData *string `json:"availability_zone_data,omitempty"`
+}
+
+type lbAvailabilityZoneProfileV2ListOpts struct {
+	Name         string `q:"name"`
+	ProviderName string `q:"provider_name"`
+}
+
+// lbAvailabilityZoneV2 represents an Octavia availability zone. Availability
+// zones are identified by their name.
+type lbAvailabilityZoneV2 struct {
+	Name                      string `json:"name"`
+	Description               string `json:"description"`
+	Enabled                   bool   `json:"enabled"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id"`
+}
+
+type lbAvailabilityZoneV2CreateOpts struct {
+	Name                      string `json:"name" required:"true"`
+	Description               string `json:"description,omitempty"`
+	Enabled                   *bool  `json:"enabled,omitempty"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id" required:"true"`
+}
+
+type lbAvailabilityZoneV2UpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+type lbAvailabilityZoneV2ListOpts struct {
+	Name                      string `q:"name"`
+	AvailabilityZoneProfileID string `q:"availability_zone_profile_id"`
+}
+
+func lbAvailabilityZoneProfileV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts lbAvailabilityZoneProfileV2CreateOpts) (*lbAvailabilityZoneProfileV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Profile lbAvailabilityZoneProfileV2 `json:"availability_zone_profile"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Profile, nil
+}
+
+func lbAvailabilityZoneProfileV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*lbAvailabilityZoneProfileV2, error) {
+	var res struct {
+		Profile lbAvailabilityZoneProfileV2 `json:"availability_zone_profile"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Profile, nil
+}
+
+func lbAvailabilityZoneProfileV2List(ctx context.Context, client *gophercloud.ServiceClient, opts lbAvailabilityZoneProfileV2ListOpts) ([]lbAvailabilityZoneProfileV2, error) {
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAllPages[lbAvailabilityZoneProfileV2](ctx, client,
+		client.ServiceURL("lbaas", "availabilityzoneprofiles")+query.String(), "availability_zone_profiles")
+}
+
+func lbAvailabilityZoneProfileV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts lbAvailabilityZoneProfileV2UpdateOpts) (*lbAvailabilityZoneProfileV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Profile lbAvailabilityZoneProfileV2 `json:"availability_zone_profile"`
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Profile, nil
+}
+
+func lbAvailabilityZoneProfileV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles", id), nil)
+
+	return err
+}
+
+func lbAvailabilityZoneV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts lbAvailabilityZoneV2CreateOpts) (*lbAvailabilityZoneV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		AvailabilityZone lbAvailabilityZoneV2 `json:"availability_zone"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("lbaas", "availabilityzones"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.AvailabilityZone, nil
+}
+
+func lbAvailabilityZoneV2Get(ctx context.Context, client *gophercloud.ServiceClient, name string) (*lbAvailabilityZoneV2, error) {
+	var res struct {
+		AvailabilityZone lbAvailabilityZoneV2 `json:"availability_zone"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("lbaas", "availabilityzones", name), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.AvailabilityZone, nil
+}
+
+func lbAvailabilityZoneV2List(ctx context.Context, client *gophercloud.ServiceClient, opts lbAvailabilityZoneV2ListOpts) ([]lbAvailabilityZoneV2, error) {
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAllPages[lbAvailabilityZoneV2](ctx, client,
+		client.ServiceURL("lbaas", "availabilityzones")+query.String(), "availability_zones")
+}
+
+func lbAvailabilityZoneV2Update(ctx context.Context, client *gophercloud.ServiceClient, name string, opts lbAvailabilityZoneV2UpdateOpts) (*lbAvailabilityZoneV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		AvailabilityZone lbAvailabilityZoneV2 `json:"availability_zone"`
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("lbaas", "availabilityzones", name), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.AvailabilityZone, nil
+}
+
+func lbAvailabilityZoneV2Delete(ctx context.Context, client *gophercloud.ServiceClient, name string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("lbaas", "availabilityzones", name), nil)
+
+	return err
+}
+
+// lbAvailabilityZoneProfileV2Keys are the availability zone data keys
+// supported by the amphora provider.
+var lbAvailabilityZoneProfileV2Keys = []string{
+	"compute_zone",
+	"management_network",
+	"valid_vip_networks",
+	"volume_zone",
+}
+
+// validateLBAvailabilityZoneData ensures availability_zone_data is a JSON
+// object. The amphora provider additionally rejects unknown keys and a
+// non-string compute_zone, so these are caught before reaching the API.
+func validateLBAvailabilityZoneData(providerName, data string) error {
+	var m map[string]any
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		return fmt.Errorf("availability_zone_data must be a JSON object: %w", err)
+	}
+
+	if providerName != "amphora" && providerName != "octavia" {
+		return nil
+	}
+
+	for k, v := range m {
+		if !strSliceContains(lbAvailabilityZoneProfileV2Keys, k) {
+			return fmt.Errorf("availability_zone_data key %q is not supported by the %s provider, expected one of %v",
+				k, providerName, lbAvailabilityZoneProfileV2Keys)
+		}
+
+		switch k {
+		case "valid_vip_networks":
+			if _, ok := v.([]any); !ok {
+				return fmt.Errorf("availability_zone_data key %q must be a list of network IDs", k)
+			}
+		default:
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("availability_zone_data key %q must be a string", k)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,832 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// lbV2FullyPopulatedCreateOpts represents the request body of a fully
+// populated load balancer. Listeners refer to their default pools by name,
+// which isn't supported by loadbalancers.CreateOpts.
+type lbV2FullyPopulatedCreateOpts struct {
+	loadbalancers.CreateOpts
+
+	// DefaultPools maps listener names to the names of their default pools.
+	DefaultPools map[string]string
+}
+
+// ToLoadBalancerCreateMap builds a request body from lbV2FullyPopulatedCreateOpts.
+func (opts lbV2FullyPopulatedCreateOpts) ToLoadBalancerCreateMap() (map[string]any, error) {
+	b, err := opts.CreateOpts.ToLoadBalancerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	lb := b["loadbalancer"].(map[string]any)
+	allListeners, _ := lb["listeners"].([]any)
+
+	for _, v := range allListeners {
+		listener := v.(map[string]any)
+		name, _ := listener["name"].(string)
+
+		if poolName, ok := opts.DefaultPools[name]; ok {
+			listener["default_pool"] = map[string]any{"name": poolName}
+		}
+	}
+
+	return b, nil
+}
+
+// lbV2ValidateFullyPopulated checks the listener and pool blocks of a load
+// balancer. Unknown names are skipped.
+func lbV2ValidateFullyPopulated(allListeners, allPools []any) error {
+	poolNames := make(map[string]bool, len(allPools))
+
+	for _, v := range allPools {
+		name := v.(map[string]any)["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		if poolNames[name] {
+			return fmt.Errorf("Duplicate pool name %q: pool names must be unique", name)
+		}
+
+		poolNames[name] = true
+	}
+
+	listenerNames := make(map[string]bool, len(allListeners))
+	defaultPools := make(map[string]string, len(allListeners))
+
+	for _, v := range allListeners {
+		listener := v.(map[string]any)
+		name := listener["name"].(string)
+		poolName := listener["default_pool_name"].(string)
+
+		if name != "" {
+			if listenerNames[name] {
+				return fmt.Errorf("Duplicate listener name %q: listener names must be unique", name)
+			}
+
+			listenerNames[name] = true
+		}
+
+		if poolName == "" {
+			continue
+		}
+
+		if !poolNames[poolName] {
+			return fmt.Errorf("Listener %q refers to the unknown default pool %q", name, poolName)
+		}
+
+		if other, ok := defaultPools[poolName]; ok {
+			return fmt.Errorf("Pool %q can't be the default pool of both the %q and %q listeners", poolName, other, name)
+		}
+
+		defaultPools[poolName] = name
+	}
+
+	return nil
+}
+
+func expandLBFullyPopulatedV2(allListeners, allPools []any) ([]listeners.CreateOpts, []pools.CreateOpts, map[string]string) {
+	listenerOpts := make([]listeners.CreateOpts, 0, len(allListeners))
+	defaultPools := make(map[string]string)
+
+	for _, v := range allListeners {
+		listener := v.(map[string]any)
+		opts := expandLBListenerBlockV2(listener)
+		listenerOpts = append(listenerOpts, opts)
+
+		if poolName := listener["default_pool_name"].(string); poolName != "" {
+			defaultPools[opts.Name] = poolName
+		}
+	}
+
+	poolOpts := make([]pools.CreateOpts, 0, len(allPools))
+
+	for _, v := range allPools {
+		pool := v.(map[string]any)
+		opts := expandLBPoolBlockV2(pool)
+
+		for _, member := range expandLBMembersV2(pool["member"].(*schema.Set)) {
+			createOpts := pools.CreateMemberOpts{
+				Address:      member.Address,
+				ProtocolPort: member.ProtocolPort,
+				Name:         *member.Name,
+				SubnetID:     *member.SubnetID,
+				Weight:       member.Weight,
+				AdminStateUp: member.AdminStateUp,
+				Backup:       member.Backup,
+				MonitorPort:  member.MonitorPort,
+			}
+
+			if member.MonitorAddress != nil {
+				createOpts.MonitorAddress = *member.MonitorAddress
+			}
+
+			opts.Members = append(opts.Members, createOpts)
+		}
+
+		if monitor := expandLBMonitorBlockV2(pool["healthmonitor"].([]any)); monitor != nil {
+			opts.Monitor = *monitor
+		}
+
+		poolOpts = append(poolOpts, opts)
+	}
+
+	return listenerOpts, poolOpts, defaultPools
+}
+
+func expandLBListenerBlockV2(listener map[string]any) listeners.CreateOpts {
+	adminStateUp := listener["admin_state_up"].(bool)
+	opts := listeners.CreateOpts{
+		Name:         listener["name"].(string),
+		Description:  listener["description"].(string),
+		Protocol:     listeners.Protocol(listener["protocol"].(string)),
+		ProtocolPort: listener["protocol_port"].(int),
+		AdminStateUp: &adminStateUp,
+	}
+
+	if connLimit := listener["connection_limit"].(int); connLimit != 0 {
+		opts.ConnLimit = &connLimit
+	}
+
+	return opts
+}
+
+func expandLBPoolBlockV2(pool map[string]any) pools.CreateOpts {
+	adminStateUp := pool["admin_state_up"].(bool)
+
+	return pools.CreateOpts{
+		Name:         pool["name"].(string),
+		Description:  pool["description"].(string),
+		Protocol:     pools.Protocol(pool["protocol"].(string)),
+		LBMethod:     pools.LBMethod(pool["lb_method"].(string)),
+		AdminStateUp: &adminStateUp,
+	}
+}
+
+func expandLBMonitorBlockV2(v []any) *monitors.CreateOpts {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	monitor := v[0].(map[string]any)
+	adminStateUp := monitor["admin_state_up"].(bool)
+
+	return &monitors.CreateOpts{
+		Type:           monitor["type"].(string),
+		Delay:          monitor["delay"].(int),
+		Timeout:        monitor["timeout"].(int),
+		MaxRetries:     monitor["max_retries"].(int),
+		MaxRetriesDown: monitor["max_retries_down"].(int),
+		URLPath:        monitor["url_path"].(string),
+		HTTPMethod:     monitor["http_method"].(string),
+		ExpectedCodes:  monitor["expected_codes"].(string),
+		AdminStateUp:   &adminStateUp,
+	}
+}
+
+func flattenLBListenerBlockV2(listener listeners.Listener, poolNames map[string]string) map[string]any {
+	return map[string]any{
+		"id":                listener.ID,
+		"name":              listener.Name,
+		"description":       listener.Description,
+		"protocol":          listener.Protocol,
+		"protocol_port":     listener.ProtocolPort,
+		"default_pool_name": poolNames[listener.DefaultPoolID],
+		"connection_limit":  listener.ConnLimit,
+		"admin_state_up":    listener.AdminStateUp,
+	}
+}
+
+func flattenLBPoolBlockV2(pool pools.Pool, members []pools.Member, monitor *monitors.Monitor) map[string]any {
+	m := map[string]any{
+		"id":             pool.ID,
+		"name":           pool.Name,
+		"description":    pool.Description,
+		"protocol":       pool.Protocol,
+		"lb_method":      pool.LBMethod,
+		"admin_state_up": pool.AdminStateUp,
+		"member":         flattenLBMembersV2(members),
+		"healthmonitor":  []map[string]any{},
+	}
+
+	if monitor != nil {
+		m["healthmonitor"] = []map[string]any{
+			{
+				"id":               monitor.ID,
+				"type":             monitor.Type,
+				"delay":            monitor.Delay,
+				"timeout":          monitor.Timeout,
+				"max_retries":      monitor.MaxRetries,
+				"max_retries_down": monitor.MaxRetriesDown,
+				"url_path":         monitor.URLPath,
+				"http_method":      monitor.HTTPMethod,
+				"expected_codes":   monitor.ExpectedCodes,
+				"admin_state_up":   monitor.AdminStateUp,
+			},
+		}
+	}
+
+	return m
+}
+
+// lbV2BlockIndex returns the index of the API object, which belongs to the
+// block with the given ID, or with the given name when the block has no ID
+// yet, e.g. right after the load balancer was created.
+func lbV2BlockIndex(id, name string, ids, names []string, used []bool) int {
+	for i := range ids {
+		if used[i] {
+			continue
+		}
+
+		if (id != "" && ids[i] == id) || (id == "" && names[i] == name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// resourceLoadBalancerV2ReadFullyPopulated refreshes the listener and pool
+// blocks of a load balancer. Listeners and pools, which don't belong to any
+// block, e.g. the ones managed by openstack_lb_listener_v2 and
+// openstack_lb_pool_v2 resources, are ignored.
+func resourceLoadBalancerV2ReadFullyPopulated(ctx context.Context, lbClient *gophercloud.ServiceClient, d *schema.ResourceData) error {
+	stateListeners := d.Get("listener").([]any)
+	statePools := d.Get("pool").([]any)
+
+	if len(stateListeners) == 0 && len(statePools) == 0 {
+		return nil
+	}
+
+	allPages, err := listeners.List(lbClient, listeners.ListOpts{LoadbalancerID: d.Id()}).AllPages(ctx)
+	if err != nil {
+		return fmt.Errorf("Unable to list listeners: %w", err)
+	}
+
+	allListeners, err := listeners.ExtractListeners(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve listeners: %w", err)
+	}
+
+	allPages, err = pools.List(lbClient, pools.ListOpts{LoadbalancerID: d.Id()}).AllPages(ctx)
+	if err != nil {
+		return fmt.Errorf("Unable to list pools: %w", err)
+	}
+
+	allPools, err := pools.ExtractPools(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve pools: %w", err)
+	}
+
+	poolIDs := make([]string, len(allPools))
+	poolNames := make([]string, len(allPools))
+	poolNamesByID := make(map[string]string, len(allPools))
+
+	for i, pool := range allPools {
+		poolIDs[i] = pool.ID
+		poolNames[i] = pool.Name
+		poolNamesByID[pool.ID] = pool.Name
+	}
+
+	used := make([]bool, len(allPools))
+	flattenedPools := make([]map[string]any, 0, len(statePools))
+
+	for _, v := range statePools {
+		block := v.(map[string]any)
+
+		i := lbV2BlockIndex(block["id"].(string), block["name"].(string), poolIDs, poolNames, used)
+		if i < 0 {
+			continue
+		}
+
+		used[i] = true
+		pool := allPools[i]
+
+		allPages, err := pools.ListMembers(lbClient, pool.ID, pools.ListMembersOpts{}).AllPages(ctx)
+		if err != nil {
+			return fmt.Errorf("Unable to list members of pool %s: %w", pool.ID, err)
+		}
+
+		members, err := pools.ExtractMembers(allPages)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve members of pool %s: %w", pool.ID, err)
+		}
+
+		var monitor *monitors.Monitor
+		if pool.MonitorID != "" {
+			monitor, err = monitors.Get(ctx, lbClient, pool.MonitorID).Extract()
+			if err != nil {
+				return fmt.Errorf("Unable to retrieve monitor %s: %w", pool.MonitorID, err)
+			}
+		}
+
+		flattenedPools = append(flattenedPools, flattenLBPoolBlockV2(pool, members, monitor))
+	}
+
+	listenerIDs := make([]string, len(allListeners))
+	listenerNames := make([]string, len(allListeners))
+
+	for i, listener := range allListeners {
+		listenerIDs[i] = listener.ID
+		listenerNames[i] = listener.Name
+	}
+
+	used = make([]bool, len(allListeners))
+	flattenedListeners := make([]map[string]any, 0, len(stateListeners))
+
+	for _, v := range stateListeners {
+		block := v.(map[string]any)
+
+		i := lbV2BlockIndex(block["id"].(string), block["name"].(string), listenerIDs, listenerNames, used)
+		if i < 0 {
+			continue
+		}
+
+		used[i] = true
+		flattenedListeners = append(flattenedListeners, flattenLBListenerBlockV2(allListeners[i], poolNamesByID))
+	}
+
+	d.Set("listener", flattenedListeners)
+	d.Set("pool", flattenedPools)
+
+	return nil
+}
+
+// lbV2BlocksByName indexes listener or pool blocks by their names.
+func lbV2BlocksByName(blocks []any) map[string]map[string]any {
+	m := make(map[string]map[string]any, len(blocks))
+
+	for _, v := range blocks {
+		block := v.(map[string]any)
+		m[block["name"].(string)] = block
+	}
+
+	return m
+}
+
+// lbV2BlockReplaced reports whether a listener or pool block can't be
+// updated in place.
+func lbV2BlockReplaced(oldBlock, newBlock map[string]any, keys ...string) bool {
+	for _, key := range keys {
+		if oldBlock[key] != newBlock[key] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resourceLoadBalancerV2UpdateFullyPopulated reconciles the listener and pool
+// blocks of a load balancer with one call per changed object. Blocks are
+// matched by their names. Listeners are removed first to free their ports and
+// pools are removed last, once no listener refers to them anymore.
+func resourceLoadBalancerV2UpdateFullyPopulated(ctx context.Context, lbClient *gophercloud.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	o, n := d.GetChange("listener")
+	oldListeners, newListeners := lbV2BlocksByName(o.([]any)), n.([]any)
+	o, n = d.GetChange("pool")
+	oldPools, newPools := lbV2BlocksByName(o.([]any)), n.([]any)
+
+	lbID := d.Id()
+	waitForLB := func() error {
+		return waitForLBV2LoadBalancer(ctx, lbClient, lbID, "ACTIVE", getLbPendingStatuses(), timeout)
+	}
+
+	keptListeners := make(map[string]bool, len(newListeners))
+
+	for _, v := range newListeners {
+		block := v.(map[string]any)
+		name := block["name"].(string)
+
+		if old, ok := oldListeners[name]; ok && !lbV2BlockReplaced(old, block, "protocol", "protocol_port") {
+			keptListeners[name] = true
+		}
+	}
+
+	for name, old := range oldListeners {
+		if keptListeners[name] {
+			continue
+		}
+
+		id := old["id"].(string)
+		log.Printf("[DEBUG] Deleting listener %s of openstack_lb_loadbalancer_v2 %s", id, lbID)
+
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			err := listeners.Delete(ctx, lbClient, id).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return fmt.Errorf("Error deleting listener %s: %w", id, err)
+		}
+
+		if err := waitForLB(); err != nil {
+			return err
+		}
+	}
+
+	poolIDs := make(map[string]string, len(newPools))
+	keptPools := make(map[string]bool, len(newPools))
+
+	for _, v := range newPools {
+		block := v.(map[string]any)
+		name := block["name"].(string)
+
+		old, ok := oldPools[name]
+		if ok && !lbV2BlockReplaced(old, block, "protocol") {
+			keptPools[name] = true
+			poolIDs[name] = old["id"].(string)
+
+			err := resourceLoadBalancerV2UpdatePoolBlock(ctx, lbClient, old, block, waitForLB, timeout)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		createOpts := expandLBPoolBlockV2(block)
+		createOpts.LoadbalancerID = lbID
+
+		log.Printf("[DEBUG] Creating pool of openstack_lb_loadbalancer_v2 %s with options: %#v", lbID, createOpts)
+
+		var pool *pools.Pool
+
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			var err error
+
+			pool, err = pools.Create(ctx, lbClient, createOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating pool %s: %w", name, err)
+		}
+
+		poolIDs[name] = pool.ID
+
+		if err := waitForLB(); err != nil {
+			return err
+		}
+
+		if members := block["member"].(*schema.Set); members.Len() > 0 {
+			err := lbV2BatchUpdateMembers(ctx, lbClient, pool.ID, members, timeout)
+			if err != nil {
+				return err
+			}
+
+			if err := waitForLB(); err != nil {
+				return err
+			}
+		}
+
+		if monitor := expandLBMonitorBlockV2(block["healthmonitor"].([]any)); monitor != nil {
+			if err := lbV2CreateMonitor(ctx, lbClient, pool.ID, *monitor, timeout); err != nil {
+				return err
+			}
+
+			if err := waitForLB(); err != nil {
+				return err
+			}
+		}
+	}
+
+	listenerIDs := make(map[string]string, len(newListeners))
+
+	for _, v := range newListeners {
+		block := v.(map[string]any)
+		name := block["name"].(string)
+		defaultPoolID := poolIDs[block["default_pool_name"].(string)]
+
+		if keptListeners[name] {
+			old := oldListeners[name]
+			id := old["id"].(string)
+			listenerIDs[name] = id
+
+			var (
+				updateOpts listeners.UpdateOpts
+				hasChange  bool
+			)
+
+			if old["description"] != block["description"] {
+				hasChange = true
+				description := block["description"].(string)
+				updateOpts.Description = &description
+			}
+
+			if old["connection_limit"] != block["connection_limit"] {
+				hasChange = true
+				connLimit := block["connection_limit"].(int)
+				updateOpts.ConnLimit = &connLimit
+			}
+
+			if old["admin_state_up"] != block["admin_state_up"] {
+				hasChange = true
+				adminStateUp := block["admin_state_up"].(bool)
+				updateOpts.AdminStateUp = &adminStateUp
+			}
+
+			// The default pool might have been replaced under the same name.
+			var oldDefaultPoolID string
+			if oldPool, ok := oldPools[old["default_pool_name"].(string)]; ok {
+				oldDefaultPoolID = oldPool["id"].(string)
+			}
+
+			if oldDefaultPoolID != defaultPoolID {
+				hasChange = true
+				updateOpts.DefaultPoolID = &defaultPoolID
+			}
+
+			if !hasChange {
+				continue
+			}
+
+			log.Printf("[DEBUG] Updating listener %s with options: %#v", id, updateOpts)
+
+			err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+				_, err := listeners.Update(ctx, lbClient, id, updateOpts).Extract()
+				if err != nil {
+					return checkForRetryableError(err)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating listener %s: %w", id, err)
+			}
+
+			if err := waitForLB(); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		createOpts := expandLBListenerBlockV2(block)
+		createOpts.LoadbalancerID = lbID
+		createOpts.DefaultPoolID = defaultPoolID
+
+		log.Printf("[DEBUG] Creating listener of openstack_lb_loadbalancer_v2 %s with options: %#v", lbID, createOpts)
+
+		var listener *listeners.Listener
+
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			var err error
+
+			listener, err = listeners.Create(ctx, lbClient, createOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating listener %s: %w", name, err)
+		}
+
+		listenerIDs[name] = listener.ID
+
+		if err := waitForLB(); err != nil {
+			return err
+		}
+	}
+
+	for name, old := range oldPools {
+		if keptPools[name] {
+			continue
+		}
+
+		id := old["id"].(string)
+		log.Printf("[DEBUG] Deleting pool %s of openstack_lb_loadbalancer_v2 %s", id, lbID)
+
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			err := pools.Delete(ctx, lbClient, id).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return fmt.Errorf("Error deleting pool %s: %w", id, err)
+		}
+
+		if err := waitForLB(); err != nil {
+			return err
+		}
+	}
+
+	// Store the IDs of the new and replaced objects, so that they can be
+	// refreshed.
+	for _, v := range newListeners {
+		block := v.(map[string]any)
+		block["id"] = listenerIDs[block["name"].(string)]
+	}
+
+	for _, v := range newPools {
+		block := v.(map[string]any)
+		block["id"] = poolIDs[block["name"].(string)]
+	}
+
+	d.Set("listener", newListeners)
+	d.Set("pool", newPools)
+
+	return nil
+}
+
+// resourceLoadBalancerV2UpdatePoolBlock updates the pool, its members and its
+// health monitor in place.
+func resourceLoadBalancerV2UpdatePoolBlock(ctx context.Context, lbClient *gophercloud.ServiceClient, old, block map[string]any, waitForLB func() error, timeout time.Duration) error {
+	id := old["id"].(string)
+
+	var (
+		updateOpts pools.UpdateOpts
+		hasChange  bool
+	)
+
+	if old["description"] != block["description"] {
+		hasChange = true
+		description := block["description"].(string)
+		updateOpts.Description = &description
+	}
+
+	if old["lb_method"] != block["lb_method"] {
+		hasChange = true
+		updateOpts.LBMethod = pools.LBMethod(block["lb_method"].(string))
+	}
+
+	if old["admin_state_up"] != block["admin_state_up"] {
+		hasChange = true
+		adminStateUp := block["admin_state_up"].(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating pool %s with options: %#v", id, updateOpts)
+
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			_, err := pools.Update(ctx, lbClient, id, updateOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating pool %s: %w", id, err)
+		}
+
+		if err := waitForLB(); err != nil {
+			return err
+		}
+	}
+
+	// The whole set of members is replaced with a single batch update.
+	if !old["member"].(*schema.Set).HashEqual(block["member"]) {
+		if err := lbV2BatchUpdateMembers(ctx, lbClient, id, block["member"].(*schema.Set), timeout); err != nil {
+			return err
+		}
+
+		if err := waitForLB(); err != nil {
+			return err
+		}
+	}
+
+	oldMonitors := old["healthmonitor"].([]any)
+	oldMonitorOpts := expandLBMonitorBlockV2(oldMonitors)
+	newMonitor := expandLBMonitorBlockV2(block["healthmonitor"].([]any))
+
+	if reflect.DeepEqual(oldMonitorOpts, newMonitor) {
+		return nil
+	}
+
+	var oldMonitor map[string]any
+	if oldMonitorOpts != nil {
+		oldMonitor = oldMonitors[0].(map[string]any)
+	}
+
+	if oldMonitor != nil && newMonitor != nil && oldMonitor["type"] == newMonitor.Type {
+		monitorID := oldMonitor["id"].(string)
+		updateOpts := monitors.UpdateOpts{
+			Delay:          newMonitor.Delay,
+			Timeout:        newMonitor.Timeout,
+			MaxRetries:     newMonitor.MaxRetries,
+			MaxRetriesDown: newMonitor.MaxRetriesDown,
+			URLPath:        newMonitor.URLPath,
+			HTTPMethod:     newMonitor.HTTPMethod,
+			ExpectedCodes:  newMonitor.ExpectedCodes,
+			AdminStateUp:   newMonitor.AdminStateUp,
+		}
+
+		log.Printf("[DEBUG] Updating monitor %s with options: %#v", monitorID, updateOpts)
+
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			_, err := monitors.Update(ctx, lbClient, monitorID, updateOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating monitor %s: %w", monitorID, err)
+		}
+
+		return waitForLB()
+	}
+
+	if oldMonitor != nil {
+		monitorID := oldMonitor["id"].(string)
+		log.Printf("[DEBUG] Deleting monitor %s of pool %s", monitorID, id)
+
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			err := monitors.Delete(ctx, lbClient, monitorID).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return fmt.Errorf("Error deleting monitor %s: %w", monitorID, err)
+		}
+
+		if err := waitForLB(); err != nil {
+			return err
+		}
+	}
+
+	if newMonitor != nil {
+		if err := lbV2CreateMonitor(ctx, lbClient, id, *newMonitor, timeout); err != nil {
+			return err
+		}
+
+		return waitForLB()
+	}
+
+	return nil
+}
+
+func lbV2BatchUpdateMembers(ctx context.Context, lbClient *gophercloud.ServiceClient, poolID string, members *schema.Set, timeout time.Duration) error {
+	updateOpts := expandLBMembersV2(members)
+	if updateOpts == nil {
+		updateOpts = []pools.BatchUpdateMemberOpts{}
+	}
+
+	log.Printf("[DEBUG] Updating members of pool %s with options: %#v", poolID, updateOpts)
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err := pools.BatchUpdateMembers(ctx, lbClient, poolID, updateOpts).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating members of pool %s: %w", poolID, err)
+	}
+
+	return nil
+}
+
+func lbV2CreateMonitor(ctx context.Context, lbClient *gophercloud.ServiceClient, poolID string, createOpts monitors.CreateOpts, timeout time.Duration) error {
+	createOpts.PoolID = poolID
+
+	log.Printf("[DEBUG] Creating monitor for pool %s with options: %#v", poolID, createOpts)
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		_, err := monitors.Create(ctx, lbClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating monitor for pool %s: %w", poolID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testLBV2ListenerBlock(name, defaultPoolName string) map[string]any {
+	return map[string]any{
+		"id":                "",
+		"name":              name,
+		"description":       "",
+		"protocol":          "HTTP",
+		"protocol_port":     80,
+		"default_pool_name": defaultPoolName,
+		"connection_limit":  0,
+		"admin_state_up":    true,
+	}
+}
+
+func testLBV2PoolBlock(name string, members []any, monitors []any) map[string]any {
+	poolSchema := resourceLoadBalancerV2().Schema["pool"].Elem.(*schema.Resource)
+	f := schema.HashResource(poolSchema.Schema["member"].Elem.(*schema.Resource))
+
+	return map[string]any{
+		"id":             "",
+		"name":           name,
+		"description":    "",
+		"protocol":       "HTTP",
+		"lb_method":      "ROUND_ROBIN",
+		"admin_state_up": true,
+		"member":         schema.NewSet(f, members),
+		"healthmonitor":  monitors,
+	}
+}
+
+func TestUnitLBV2ValidateFullyPopulated(t *testing.T) {
+	pools := []any{
+		testLBV2PoolBlock("pool_1", nil, nil),
+		testLBV2PoolBlock("pool_2", nil, nil),
+	}
+
+	err := lbV2ValidateFullyPopulated([]any{
+		testLBV2ListenerBlock("listener_1", "pool_1"),
+		testLBV2ListenerBlock("listener_2", ""),
+		testLBV2ListenerBlock("", "pool_2"),
+	}, pools)
+	require.NoError(t, err)
+
+	err = lbV2ValidateFullyPopulated([]any{
+		testLBV2ListenerBlock("listener_1", ""),
+		testLBV2ListenerBlock("listener_1", ""),
+	}, pools)
+	assert.ErrorContains(t, err, `Duplicate listener name "listener_1"`)
+
+	err = lbV2ValidateFullyPopulated(nil, append(pools, testLBV2PoolBlock("pool_1", nil, nil)))
+	assert.ErrorContains(t, err, `Duplicate pool name "pool_1"`)
+
+	err = lbV2ValidateFullyPopulated([]any{
+		testLBV2ListenerBlock("listener_1", "pool_3"),
+	}, pools)
+	assert.ErrorContains(t, err, `unknown default pool "pool_3"`)
+
+	err = lbV2ValidateFullyPopulated([]any{
+		testLBV2ListenerBlock("listener_1", "pool_1"),
+		testLBV2ListenerBlock("listener_2", "pool_1"),
+	}, pools)
+	assert.ErrorContains(t, err, `Pool "pool_1" can't be the default pool`)
+}
+
+func TestUnitLBV2FullyPopulatedCreateOpts(t *testing.T) {
+	allListeners := []any{
+		testLBV2ListenerBlock("listener_1", "pool_1"),
+	}
+	allPools := []any{
+		testLBV2PoolBlock("pool_1", []any{
+			map[string]any{
+				"id":              "",
+				"name":            "",
+				"address":         "192.168.199.110",
+				"protocol_port":   8080,
+				"weight":          1,
+				"monitor_port":    0,
+				"monitor_address": "",
+				"subnet_id":       "",
+				"backup":          false,
+				"admin_state_up":  true,
+			},
+		}, []any{
+			map[string]any{
+				"id":               "",
+				"type":             "HTTP",
+				"delay":            10,
+				"timeout":          5,
+				"max_retries":      3,
+				"max_retries_down": 0,
+				"url_path":         "/health",
+				"http_method":      "",
+				"expected_codes":   "",
+				"admin_state_up":   true,
+			},
+		}),
+	}
+
+	opts := lbV2FullyPopulatedCreateOpts{
+		CreateOpts: loadbalancers.CreateOpts{
+			Name:        "loadbalancer_1",
+			VipSubnetID: "subnet-id",
+		},
+	}
+	opts.Listeners, opts.Pools, opts.DefaultPools = expandLBFullyPopulatedV2(allListeners, allPools)
+
+	b, err := opts.ToLoadBalancerCreateMap()
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"loadbalancer": map[string]any{
+			"name":          "loadbalancer_1",
+			"vip_subnet_id": "subnet-id",
+			"listeners": []any{
+				map[string]any{
+					"name":           "listener_1",
+					"protocol":       "HTTP",
+					"protocol_port":  float64(80),
+					"admin_state_up": true,
+					"default_pool": map[string]any{
+						"name": "pool_1",
+					},
+				},
+			},
+			"pools": []any{
+				map[string]any{
+					"name":           "pool_1",
+					"protocol":       "HTTP",
+					"lb_algorithm":   "ROUND_ROBIN",
+					"admin_state_up": true,
+					"members": []any{
+						map[string]any{
+							"address":        "192.168.199.110",
+							"protocol_port":  float64(8080),
+							"weight":         float64(1),
+							"backup":         false,
+							"admin_state_up": true,
+						},
+					},
+					"healthmonitor": map[string]any{
+						"type":           "HTTP",
+						"delay":          float64(10),
+						"timeout":        float64(5),
+						"max_retries":    float64(3),
+						"url_path":       "/health",
+						"admin_state_up": true,
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, b)
+}
@@ -6,14 +6,17 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/amphorae"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -884,6 +887,58 @@ func resourceLoadBalancerV2SetSecurityGroups(ctx context.Context, networkingClie
 	return nil
 }
 
+// lbV2VIPPortDeviceOwner is the device owner of the VIP ports created by
+// Octavia.
+const lbV2VIPPortDeviceOwner = "Octavia"
+
+// resourceLoadBalancerV2CleanupVIPPort deletes the VIP port of a deleted load
+// balancer and its floating IPs, when Octavia left them behind. Ports, which
+// were not created by Octavia, are kept.
+func resourceLoadBalancerV2CleanupVIPPort(ctx context.Context, networkingClient *gophercloud.ServiceClient, vipPortID string) error {
+	port, err := ports.Get(ctx, networkingClient, vipPortID).Extract()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if port.DeviceOwner != lbV2VIPPortDeviceOwner {
+		log.Printf("[DEBUG] Keeping VIP port %s, because it is owned by %q", vipPortID, port.DeviceOwner)
+
+		return nil
+	}
+
+	allPages, err := floatingips.List(networkingClient, floatingips.ListOpts{PortID: vipPortID}).AllPages(ctx)
+	if err != nil {
+		return fmt.Errorf("Unable to list floating IPs of port %s: %w", vipPortID, err)
+	}
+
+	allFips, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract floating IPs of port %s: %w", vipPortID, err)
+	}
+
+	for _, fip := range allFips {
+		log.Printf("[DEBUG] Deleting leftover floating IP %s of VIP port %s", fip.ID, vipPortID)
+
+		err := floatingips.Delete(ctx, networkingClient, fip.ID).ExtractErr()
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return fmt.Errorf("Unable to delete floating IP %s: %w", fip.ID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting leftover VIP port %s", vipPortID)
+
+	err = ports.Delete(ctx, networkingClient, vipPortID).ExtractErr()
+	if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return err
+	}
+
+	return nil
+}
+
 func resourceLoadBalancerV2GetSecurityGroups(ctx context.Context, networkingClient *gophercloud.ServiceClient, vipPortID string, d *schema.ResourceData) error {
 	port, err := ports.Get(ctx, networkingClient, vipPortID).Extract()
 	if err != nil {
@@ -894,3 +949,529 @@ func resourceLoadBalancerV2GetSecurityGroups(ctx context.Context, networkingClie
 
 	return nil
 }
+
+// lbStatusTreeV2 is the Octavia load balancer status tree. It is decoded
+// locally, because Octavia returns the pool health monitor under the
+// "health_monitor" key, while gophercloud expects "healthmonitor".
+type lbStatusTreeV2 struct {
+	ID                 string                   `json:"id"`
+	Name               string                   `json:"name"`
+	ProvisioningStatus string                   `json:"provisioning_status"`
+	OperatingStatus    string                   `json:"operating_status"`
+	Listeners          []lbStatusTreeListenerV2 `json:"listeners"`
+}
+
+type lbStatusTreeListenerV2 struct {
+	ID                 string               `json:"id"`
+	Name               string               `json:"name"`
+	ProvisioningStatus string               `json:"provisioning_status"`
+	OperatingStatus    string               `json:"operating_status"`
+	Pools              []lbStatusTreePoolV2 `json:"pools"`
+}
+
+type lbStatusTreePoolV2 struct {
+	ID                  string                 `json:"id"`
+	Name                string                 `json:"name"`
+	ProvisioningStatus  string                 `json:"provisioning_status"`
+	OperatingStatus     string                 `json:"operating_status"`
+	HealthMonitor       *lbStatusTreeMonitorV2 `json:"health_monitor"`
+	LegacyHealthMonitor *lbStatusTreeMonitorV2 `json:"healthmonitor"`
+	Members             []lbStatusTreeMemberV2 `json:"members"`
+}
+
+type lbStatusTreeMonitorV2 struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Type               string `json:"type"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+}
+
+type lbStatusTreeMemberV2 struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Address            string `json:"address"`
+	ProtocolPort       int    `json:"protocol_port"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+}
+
+func lbV2GetStatusTree(ctx context.Context, lbClient *gophercloud.ServiceClient, lbID string) (*lbStatusTreeV2, error) {
+	var s struct {
+		Statuses struct {
+			Loadbalancer *lbStatusTreeV2 `json:"loadbalancer"`
+		} `json:"statuses"`
+	}
+
+	err := loadbalancers.GetStatuses(ctx, lbClient, lbID).ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Statuses.Loadbalancer == nil {
+		return nil, fmt.Errorf("Load balancer %s status tree is empty", lbID)
+	}
+
+	return s.Statuses.Loadbalancer, nil
+}
+
+func flattenLBStatusTreeListenersV2(listeners []lbStatusTreeListenerV2) []map[string]any {
+	res := make([]map[string]any, 0, len(listeners))
+
+	for _, l := range listeners {
+		res = append(res, map[string]any{
+			"id":                  l.ID,
+			"name":                l.Name,
+			"provisioning_status": l.ProvisioningStatus,
+			"operating_status":    l.OperatingStatus,
+			"pool":                flattenLBStatusTreePoolsV2(l.Pools),
+		})
+	}
+
+	return res
+}
+
+func flattenLBStatusTreePoolsV2(pools []lbStatusTreePoolV2) []map[string]any {
+	res := make([]map[string]any, 0, len(pools))
+
+	for _, p := range pools {
+		monitor := p.HealthMonitor
+		if monitor == nil {
+			monitor = p.LegacyHealthMonitor
+		}
+
+		var healthmonitor []map[string]any
+		if monitor != nil && monitor.ID != "" {
+			healthmonitor = []map[string]any{
+				{
+					"id":                  monitor.ID,
+					"name":                monitor.Name,
+					"type":                monitor.Type,
+					"provisioning_status": monitor.ProvisioningStatus,
+					"operating_status":    monitor.OperatingStatus,
+				},
+			}
+		}
+
+		members := make([]map[string]any, 0, len(p.Members))
+		for _, m := range p.Members {
+			members = append(members, map[string]any{
+				"id":                  m.ID,
+				"name":                m.Name,
+				"address":             m.Address,
+				"protocol_port":       m.ProtocolPort,
+				"provisioning_status": m.ProvisioningStatus,
+				"operating_status":    m.OperatingStatus,
+			})
+		}
+
+		res = append(res, map[string]any{
+			"id":                  p.ID,
+			"name":                p.Name,
+			"provisioning_status": p.ProvisioningStatus,
+			"operating_status":    p.OperatingStatus,
+			"healthmonitor":       healthmonitor,
+			"member":              members,
+		})
+	}
+
+	return res
+}
+
+func lbV2SetStats(d *schema.ResourceData, stats *loadbalancers.Stats) {
+	d.Set("active_connections", stats.ActiveConnections)
+	d.Set("bytes_in", stats.BytesIn)
+	d.Set("bytes_out", stats.BytesOut)
+	d.Set("request_errors", stats.RequestErrors)
+	d.Set("total_connections", stats.TotalConnections)
+}
+
+func flattenLBAmphoraeV2(allAmphorae []amphorae.Amphora) []map[string]any {
+	res := make([]map[string]any, len(allAmphorae))
+	for i, a := range allAmphorae {
+		res[i] = map[string]any{
+			"id":              a.ID,
+			"loadbalancer_id": a.LoadbalancerID,
+			"compute_id":      a.ComputeID,
+			"lb_network_ip":   a.LBNetworkIP,
+			"ha_ip":           a.HAIP,
+			"ha_port_id":      a.HAPortID,
+			"vrrp_ip":         a.VRRPIP,
+			"vrrp_port_id":    a.VRRPPortID,
+			"role":            a.Role,
+			"status":          a.Status,
+			"image_id":        a.ImageID,
+			"cached_zone":     a.CachedZone,
+			"cert_busy":       a.CertBusy,
+			"cert_expiration": a.CertExpiration.Format(time.RFC3339),
+			"created_at":      a.CreatedAt.Format(time.RFC3339),
+			"updated_at":      a.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return res
+}
+
+// resourceLoadBalancerV2CustomizeDiff validates at plan time, that the
+// requested availability_zone is enabled. The validation is skipped, when the
+// availability zone is not known yet or can't be queried, e.g. because it is
+// created in the same apply.
+func resourceLoadBalancerV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChange("availability_zone") || !d.NewValueKnown("availability_zone") {
+		return nil
+	}
+
+	azName := d.Get("availability_zone").(string)
+	if azName == "" {
+		return nil
+	}
+
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegionFromResourceDiff(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack loadbalancing client: %w", err)
+	}
+
+	az, err := lbAvailabilityZoneV2Get(ctx, lbClient, azName)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve availability zone %s to validate openstack_lb_loadbalancer_v2: %s", azName, err)
+
+		return nil
+	}
+
+	if !az.Enabled {
+		return fmt.Errorf("Load balancer availability zone %q is disabled", azName)
+	}
+
+	return nil
+}
+
+// lbV2DrainPollInterval is the interval at which the active connections are
+// polled while draining members.
+var lbV2DrainPollInterval = 5 * time.Second
+
+// lbV2WaitForMembersDrain waits for the active connections served by a pool
+// to drop to zero, or for the drain timeout to elapse. Octavia doesn't expose
+// per member statistics, so the statistics of the pool listeners, or of the
+// pool load balancer when the pool has no listener, are used instead.
+func lbV2WaitForMembersDrain(ctx context.Context, lbClient *gophercloud.ServiceClient, pool *pools.Pool, drainTimeout time.Duration) error {
+	deadline := time.Now().Add(drainTimeout)
+
+	for {
+		active, err := lbV2PoolActiveConnections(ctx, lbClient, pool)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to retrieve active connections of pool %s: %s", pool.ID, err)
+		} else if active == 0 {
+			log.Printf("[DEBUG] Pool %s has no active connections left", pool.ID)
+
+			return nil
+		}
+
+		if !time.Now().Before(deadline) {
+			if err != nil {
+				log.Printf("[DEBUG] Drain timeout of pool %s elapsed, its active connections are unknown", pool.ID)
+			} else {
+				log.Printf("[DEBUG] Drain timeout of pool %s elapsed with %d active connections", pool.ID, active)
+			}
+
+			return nil
+		}
+
+		if err == nil {
+			log.Printf("[DEBUG] Waiting for %d active connections of pool %s to drain", active, pool.ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Error waiting for pool %s members to drain: %w", pool.ID, ctx.Err())
+		case <-time.After(min(lbV2DrainPollInterval, time.Until(deadline))):
+		}
+	}
+}
+
+func lbV2PoolActiveConnections(ctx context.Context, lbClient *gophercloud.ServiceClient, pool *pools.Pool) (int, error) {
+	var active int
+
+	if len(pool.Listeners) > 0 {
+		for _, l := range pool.Listeners {
+			stats, err := listeners.GetStats(ctx, lbClient, l.ID).Extract()
+			if err != nil {
+				return 0, err
+			}
+
+			active += stats.ActiveConnections
+		}
+
+		return active, nil
+	}
+
+	for _, lb := range pool.Loadbalancers {
+		stats, err := loadbalancers.GetStats(ctx, lbClient, lb.ID).Extract()
+		if err != nil {
+			return 0, err
+		}
+
+		active += stats.ActiveConnections
+	}
+
+	return active, nil
+}
+
+// lbV2MembersToDrain returns the members of oldMembers, which are not part of
+// newMembers anymore, with their weight set to 0. Members are matched by
+// their address and protocol port.
+func lbV2MembersToDrain(oldMembers, newMembers *schema.Set) []any {
+	keep := make(map[string]bool, newMembers.Len())
+
+	for _, raw := range newMembers.List() {
+		m := raw.(map[string]any)
+		keep[fmt.Sprintf("%s:%d", m["address"], m["protocol_port"])] = true
+	}
+
+	var drain []any
+
+	for _, raw := range oldMembers.List() {
+		m := raw.(map[string]any)
+		if keep[fmt.Sprintf("%s:%d", m["address"], m["protocol_port"])] {
+			continue
+		}
+
+		drained := make(map[string]any, len(m))
+		for k, v := range m {
+			drained[k] = v
+		}
+
+		drained["weight"] = 0
+		drain = append(drain, drained)
+	}
+
+	return drain
+}
+
+func expandL7PolicyV2Rules(rules []any) ([]l7policies.CreateRuleOpts, error) {
+	createOpts := make([]l7policies.CreateRuleOpts, 0, len(rules))
+
+	for _, v := range rules {
+		rule := v.(map[string]any)
+		ruleType := rule["type"].(string)
+		key := rule["key"].(string)
+
+		err := checkL7RuleType(ruleType, key)
+		if err != nil {
+			return nil, err
+		}
+
+		createOpts = append(createOpts, l7policies.CreateRuleOpts{
+			RuleType:    l7policies.RuleType(ruleType),
+			CompareType: l7policies.CompareType(rule["compare_type"].(string)),
+			Key:         key,
+			Value:       rule["value"].(string),
+			Invert:      rule["invert"].(bool),
+		})
+	}
+
+	return createOpts, nil
+}
+
+func listL7PolicyV2Rules(ctx context.Context, lbClient *gophercloud.ServiceClient, l7policyID string) ([]l7policies.Rule, error) {
+	allPages, err := l7policies.ListRules(lbClient, l7policyID, l7policies.ListRulesOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return l7policies.ExtractRules(allPages)
+}
+
+// l7PolicyV2RuleMatches reports whether an API rule has the same settings as
+// a rule block.
+func l7PolicyV2RuleMatches(rule map[string]any, apiRule l7policies.Rule) bool {
+	return strings.EqualFold(rule["type"].(string), apiRule.RuleType) &&
+		strings.EqualFold(rule["compare_type"].(string), apiRule.CompareType) &&
+		rule["key"].(string) == apiRule.Key &&
+		rule["value"].(string) == apiRule.Value &&
+		rule["invert"].(bool) == apiRule.Invert
+}
+
+// flattenL7PolicyV2Rules maps the rules of an L7 Policy onto the rule blocks
+// in the state, keeping their order. Rule blocks without an ID, e.g. right
+// after the policy was created, are matched by their settings. The IDs of
+// the rules which don't belong to any rule block are returned separately.
+func flattenL7PolicyV2Rules(stateRules []any, apiRules []l7policies.Rule) ([]map[string]any, []string) {
+	used := make([]bool, len(apiRules))
+	rules := make([]map[string]any, 0, len(stateRules))
+
+	for _, v := range stateRules {
+		rule := v.(map[string]any)
+		id := rule["id"].(string)
+
+		for i, apiRule := range apiRules {
+			if used[i] {
+				continue
+			}
+
+			if (id != "" && apiRule.ID == id) || (id == "" && l7PolicyV2RuleMatches(rule, apiRule)) {
+				used[i] = true
+				rules = append(rules, map[string]any{
+					"id":           apiRule.ID,
+					"type":         apiRule.RuleType,
+					"compare_type": apiRule.CompareType,
+					"key":          apiRule.Key,
+					"value":        apiRule.Value,
+					"invert":       apiRule.Invert,
+				})
+
+				break
+			}
+		}
+	}
+
+	var unmanaged []string
+
+	for i, apiRule := range apiRules {
+		if !used[i] {
+			unmanaged = append(unmanaged, apiRule.ID)
+		}
+	}
+
+	return rules, unmanaged
+}
+
+func l7PolicyV2RulesConflictMessage(l7policyID string, unmanaged []string) string {
+	return fmt.Sprintf("L7 Policy %s has rules which are not managed by its rule blocks: %s. "+
+		"The rule block can't be used together with openstack_lb_l7rule_v2 resources targeting the same L7 Policy",
+		l7policyID, strings.Join(unmanaged, ", "))
+}
+
+// updateL7PolicyV2Rules reconciles the rules of an L7 Policy in place:
+// rule blocks are matched by their position, changed rules are updated,
+// surplus rules are deleted and new rules are created. It returns the new
+// rule blocks with their IDs populated.
+func updateL7PolicyV2Rules(ctx context.Context, lbClient *gophercloud.ServiceClient, parentListener *listeners.Listener, l7Policy *l7policies.L7Policy, oldRules, newRules []any, timeout time.Duration) ([]map[string]any, error) {
+	apiRules, err := listL7PolicyV2Rules(ctx, lbClient, l7Policy.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve rules of L7 Policy %s: %w", l7Policy.ID, err)
+	}
+
+	// Refuse to touch the rules when some of them are owned by someone else.
+	if _, unmanaged := flattenL7PolicyV2Rules(oldRules, apiRules); len(unmanaged) > 0 {
+		return nil, errors.New(l7PolicyV2RulesConflictMessage(l7Policy.ID, unmanaged))
+	}
+
+	for i := len(newRules); i < len(oldRules); i++ {
+		ruleID := oldRules[i].(map[string]any)["id"].(string)
+
+		log.Printf("[DEBUG] Attempting to delete L7 Rule %s of L7 Policy %s", ruleID, l7Policy.ID)
+
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			err = l7policies.DeleteRule(ctx, lbClient, l7Policy.ID, ruleID).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return nil, fmt.Errorf("Error deleting L7 Rule %s: %w", ruleID, err)
+		}
+
+		err = waitForLBV2L7Policy(ctx, lbClient, parentListener, l7Policy, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rules := make([]map[string]any, 0, len(newRules))
+
+	for i, v := range newRules {
+		rule := v.(map[string]any)
+		ruleType := rule["type"].(string)
+		compareType := rule["compare_type"].(string)
+		key := rule["key"].(string)
+		value := rule["value"].(string)
+		invert := rule["invert"].(bool)
+
+		err = checkL7RuleType(ruleType, key)
+		if err != nil {
+			return nil, err
+		}
+
+		var ruleID string
+		if i < len(oldRules) {
+			ruleID = oldRules[i].(map[string]any)["id"].(string)
+		}
+
+		switch {
+		case ruleID == "":
+			createOpts := l7policies.CreateRuleOpts{
+				RuleType:    l7policies.RuleType(ruleType),
+				CompareType: l7policies.CompareType(compareType),
+				Key:         key,
+				Value:       value,
+				Invert:      invert,
+			}
+
+			log.Printf("[DEBUG] Creating L7 Rule for L7 Policy %s with options: %#v", l7Policy.ID, createOpts)
+
+			var l7Rule *l7policies.Rule
+
+			err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+				l7Rule, err = l7policies.CreateRule(ctx, lbClient, l7Policy.ID, createOpts).Extract()
+				if err != nil {
+					return checkForRetryableError(err)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("Error creating L7 Rule: %w", err)
+			}
+
+			ruleID = l7Rule.ID
+		case !l7PolicyV2RuleMatches(oldRules[i].(map[string]any), l7policies.Rule{
+			RuleType:    ruleType,
+			CompareType: compareType,
+			Key:         key,
+			Value:       value,
+			Invert:      invert,
+		}):
+			updateOpts := l7policies.UpdateRuleOpts{
+				RuleType:    l7policies.RuleType(ruleType),
+				CompareType: l7policies.CompareType(compareType),
+				Key:         &key,
+				Value:       value,
+				Invert:      &invert,
+			}
+
+			log.Printf("[DEBUG] Updating L7 Rule %s with options: %#v", ruleID, updateOpts)
+
+			err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+				_, err = l7policies.UpdateRule(ctx, lbClient, l7Policy.ID, ruleID, updateOpts).Extract()
+				if err != nil {
+					return checkForRetryableError(err)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("Unable to update L7 Rule %s: %w", ruleID, err)
+			}
+		default:
+			// The rule is unchanged.
+			rule["id"] = ruleID
+			rules = append(rules, rule)
+
+			continue
+		}
+
+		err = waitForLBV2L7Policy(ctx, lbClient, parentListener, l7Policy, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		rule["id"] = ruleID
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
@@ -1,9 +1,19 @@
 package openstack
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLBV2FlavorCreateOpts(t *testing.T) {
@@ -31,3 +41,357 @@ func TestLBV2FlavorCreateOpts(t *testing.T) {
 		t.Fatalf("Values are not the same:\n%s", diff)
 	}
 }
+
+func TestUnitLBV2GetStatusTree(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/lbaas/loadbalancers/lb_1/status", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprint(w, `{
+  "statuses": {
+    "loadbalancer": {
+      "id": "lb_1",
+      "name": "loadbalancer_1",
+      "provisioning_status": "ACTIVE",
+      "operating_status": "DEGRADED",
+      "listeners": [
+        {
+          "id": "listener_1",
+          "name": "listener_1",
+          "provisioning_status": "ACTIVE",
+          "operating_status": "DEGRADED",
+          "pools": [
+            {
+              "id": "pool_1",
+              "name": "pool_1",
+              "provisioning_status": "ACTIVE",
+              "operating_status": "DEGRADED",
+              "health_monitor": {
+                "id": "monitor_1",
+                "name": "monitor_1",
+                "type": "HTTP",
+                "provisioning_status": "ACTIVE",
+                "operating_status": "ONLINE"
+              },
+              "members": [
+                {
+                  "id": "member_1",
+                  "name": "member_1",
+                  "address": "192.168.199.10",
+                  "protocol_port": 8080,
+                  "provisioning_status": "ACTIVE",
+                  "operating_status": "ERROR"
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	tree, err := lbV2GetStatusTree(t.Context(), client, "lb_1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "loadbalancer_1", tree.Name)
+	assert.Equal(t, "DEGRADED", tree.OperatingStatus)
+
+	expected := []map[string]any{
+		{
+			"id":                  "listener_1",
+			"name":                "listener_1",
+			"provisioning_status": "ACTIVE",
+			"operating_status":    "DEGRADED",
+			"pool": []map[string]any{
+				{
+					"id":                  "pool_1",
+					"name":                "pool_1",
+					"provisioning_status": "ACTIVE",
+					"operating_status":    "DEGRADED",
+					"healthmonitor": []map[string]any{
+						{
+							"id":                  "monitor_1",
+							"name":                "monitor_1",
+							"type":                "HTTP",
+							"provisioning_status": "ACTIVE",
+							"operating_status":    "ONLINE",
+						},
+					},
+					"member": []map[string]any{
+						{
+							"id":                  "member_1",
+							"name":                "member_1",
+							"address":             "192.168.199.10",
+							"protocol_port":       8080,
+							"provisioning_status": "ACTIVE",
+							"operating_status":    "ERROR",
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, flattenLBStatusTreeListenersV2(tree.Listeners))
+}
+
+func TestUnitValidateLBAvailabilityZoneData(t *testing.T) {
+	testCases := []struct {
+		providerName string
+		data         string
+		err          string
+	}{
+		{"amphora", `{"compute_zone": "nova", "management_network": "net", "valid_vip_networks": ["net"]}`, ""},
+		{"amphora", `["compute_zone"]`, "must be a JSON object"},
+		{"amphora", `{"compute_zones": "nova"}`, `key "compute_zones" is not supported`},
+		{"octavia", `{"compute_zone": 1}`, `key "compute_zone" must be a string`},
+		{"amphora", `{"valid_vip_networks": "net"}`, `key "valid_vip_networks" must be a list`},
+		{"ovn", `{"any_key": 1}`, ""},
+	}
+
+	for _, tc := range testCases {
+		err := validateLBAvailabilityZoneData(tc.providerName, tc.data)
+		if tc.err == "" {
+			assert.NoError(t, err, tc.data)
+
+			continue
+		}
+
+		assert.ErrorContains(t, err, tc.err, tc.data)
+	}
+}
+
+func TestUnitLBV2MembersToDrain(t *testing.T) {
+	membersSchema := resourceMembersV2().Schema["member"]
+	f := schema.HashResource(membersSchema.Elem.(*schema.Resource))
+
+	member := func(address string, port, weight int) map[string]any {
+		return map[string]any{
+			"id":              "",
+			"name":            "",
+			"address":         address,
+			"protocol_port":   port,
+			"weight":          weight,
+			"monitor_port":    0,
+			"monitor_address": "",
+			"subnet_id":       "",
+			"backup":          false,
+			"admin_state_up":  true,
+		}
+	}
+
+	oldMembers := schema.NewSet(f, []any{
+		member("192.168.199.110", 8080, 1),
+		member("192.168.199.111", 8080, 1),
+		member("192.168.199.112", 8080, 1),
+	})
+	newMembers := schema.NewSet(f, []any{
+		member("192.168.199.110", 8080, 5),
+		member("192.168.199.112", 8080, 1),
+	})
+
+	expected := []any{member("192.168.199.111", 8080, 0)}
+	assert.Equal(t, expected, lbV2MembersToDrain(oldMembers, newMembers))
+	assert.Empty(t, lbV2MembersToDrain(newMembers, newMembers))
+	assert.Len(t, lbV2MembersToDrain(oldMembers, schema.NewSet(f, nil)), 3)
+}
+
+func TestUnitLBV2WaitForMembersDrain(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	defer func(interval time.Duration) { lbV2DrainPollInterval = interval }(lbV2DrainPollInterval)
+	lbV2DrainPollInterval = time.Millisecond
+
+	var calls int
+
+	fakeServer.Mux.HandleFunc("/lbaas/listeners/listener_1/stats", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		calls++
+
+		active := 0
+		if calls < 3 {
+			active = 2
+		}
+
+		fmt.Fprintf(w, `{"stats": {"active_connections": %d, "bytes_in": 0, "bytes_out": 0, "request_errors": 0, "total_connections": 10}}`, active)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	pool := &pools.Pool{
+		ID:        "pool_1",
+		Listeners: []pools.ListenerID{{ID: "listener_1"}},
+	}
+
+	err := lbV2WaitForMembersDrain(t.Context(), client, pool, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+
+	err = lbV2WaitForMembersDrain(t.Context(), client, pool, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestUnitLBV2WaitForMembersDrainBusyPool(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	defer func(interval time.Duration) { lbV2DrainPollInterval = interval }(lbV2DrainPollInterval)
+	lbV2DrainPollInterval = time.Millisecond
+
+	var calls int
+
+	// A member, which is not drained, keeps serving connections of the pool.
+	fakeServer.Mux.HandleFunc("/lbaas/listeners/listener_1/stats", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		calls++
+
+		fmt.Fprint(w, `{"stats": {"active_connections": 1, "bytes_in": 0, "bytes_out": 0, "request_errors": 0, "total_connections": 10}}`)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	pool := &pools.Pool{
+		ID:        "pool_1",
+		Listeners: []pools.ListenerID{{ID: "listener_1"}},
+	}
+
+	drainTimeout := 50 * time.Millisecond
+	start := time.Now()
+
+	err := lbV2WaitForMembersDrain(t.Context(), client, pool, drainTimeout)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), drainTimeout)
+	assert.Greater(t, calls, 1)
+}
+
+func TestUnitLBV2CleanupVIPPort(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	var deleted []string
+
+	fakeServer.Mux.HandleFunc("/ports/port_1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, "port_1")
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprint(w, `{"port": {"id": "port_1", "device_owner": "Octavia"}}`)
+	})
+
+	fakeServer.Mux.HandleFunc("/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+		th.TestFormValues(t, r, map[string]string{"port_id": "port_1"})
+
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprint(w, `{"floatingips": [{"id": "fip_1", "port_id": "port_1"}]}`)
+	})
+
+	fakeServer.Mux.HandleFunc("/floatingips/fip_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodDelete)
+
+		deleted = append(deleted, "fip_1")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	err := resourceLoadBalancerV2CleanupVIPPort(t.Context(), client, "port_1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fip_1", "port_1"}, deleted)
+}
+
+func TestUnitLBV2CleanupVIPPortUserSupplied(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/ports/port_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprint(w, `{"port": {"id": "port_1", "device_owner": "compute:nova"}}`)
+	})
+
+	fakeServer.Mux.HandleFunc("/floatingips", func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected %s request for the floating IPs of a user supplied VIP port", r.Method)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	err := resourceLoadBalancerV2CleanupVIPPort(t.Context(), client, "port_1")
+	require.NoError(t, err)
+}
+
+func TestUnitFlattenL7PolicyV2Rules(t *testing.T) {
+	apiRules := []l7policies.Rule{
+		{ID: "rule-1", RuleType: "PATH", CompareType: "STARTS_WITH", Value: "/api"},
+		{ID: "rule-2", RuleType: "HEADER", CompareType: "EQUAL_TO", Key: "X-Ref", Value: "foo", Invert: true},
+		{ID: "rule-3", RuleType: "HOST_NAME", CompareType: "EQUAL_TO", Value: "www.example.com"},
+	}
+
+	rule := func(id, ruleType, compareType, key, value string, invert bool) map[string]any {
+		return map[string]any{
+			"id":           id,
+			"type":         ruleType,
+			"compare_type": compareType,
+			"key":          key,
+			"value":        value,
+			"invert":       invert,
+		}
+	}
+
+	// Rule blocks without an ID are matched by their settings.
+	stateRules := []any{
+		rule("", "header", "equal_to", "X-Ref", "foo", true),
+		rule("", "PATH", "STARTS_WITH", "", "/api", false),
+	}
+
+	rules, unmanaged := flattenL7PolicyV2Rules(stateRules, apiRules)
+	assert.Equal(t, []map[string]any{
+		rule("rule-2", "HEADER", "EQUAL_TO", "X-Ref", "foo", true),
+		rule("rule-1", "PATH", "STARTS_WITH", "", "/api", false),
+	}, rules)
+	assert.Equal(t, []string{"rule-3"}, unmanaged)
+
+	// Rule blocks with an ID pick up the remote settings and vanish when
+	// the rule is gone.
+	stateRules = []any{
+		rule("rule-1", "PATH", "EQUAL_TO", "", "/old", false),
+		rule("rule-4", "PATH", "EQUAL_TO", "", "/gone", false),
+		rule("rule-3", "HOST_NAME", "EQUAL_TO", "", "www.example.com", false),
+		rule("rule-2", "HEADER", "EQUAL_TO", "X-Ref", "foo", true),
+	}
+
+	rules, unmanaged = flattenL7PolicyV2Rules(stateRules, apiRules)
+	assert.Equal(t, []map[string]any{
+		rule("rule-1", "PATH", "STARTS_WITH", "", "/api", false),
+		rule("rule-3", "HOST_NAME", "EQUAL_TO", "", "www.example.com", false),
+		rule("rule-2", "HEADER", "EQUAL_TO", "X-Ref", "foo", true),
+	}, rules)
+	assert.Empty(t, unmanaged)
+}
@@ -0,0 +1,77 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// networkingDefaultSecGroupRuleV2 represents a Neutron default security group
+// rule, which is used as a template for the rules of new security groups.
+type networkingDefaultSecGroupRuleV2 struct {
+	ID                   string `json:"id"`
+	Description          string `json:"description"`
+	Direction            string `json:"direction"`
+	EtherType            string `json:"ethertype"`
+	Protocol             string `json:"protocol"`
+	PortRangeMin         int    `json:"port_range_min"`
+	PortRangeMax         int    `json:"port_range_max"`
+	RemoteIPPrefix       string `json:"remote_ip_prefix"`
+	RemoteGroupID        string `json:"remote_group_id"`
+	RemoteAddressGroupID string `json:"remote_address_group_id"`
+	UsedInDefaultSG      bool   `json:"used_in_default_sg"`
+	UsedInNonDefaultSG   bool   `json:"used_in_non_default_sg"`
+}
+
+type networkingDefaultSecGroupRuleV2CreateOpts struct {
+	Description          string `json:"description,omitempty"`
+	Direction            string `json:"direction" required:"true"`
+	EtherType            string `json:"ethertype,omitempty"`
+	Protocol             string `json:"protocol,omitempty"`
+	PortRangeMin         int    `json:"port_range_min,omitempty"`
+	PortRangeMax         int    `json:"port_range_max,omitempty"`
+	RemoteIPPrefix       string `json:"remote_ip_prefix,omitempty"`
+	RemoteGroupID        string `json:"remote_group_id,omitempty"`
+	RemoteAddressGroupID string `json:"remote_address_group_id,omitempty"`
+	UsedInDefaultSG      *bool  `json:"used_in_default_sg,omitempty"`
+	UsedInNonDefaultSG   *bool  `json:"used_in_non_default_sg,omitempty"`
+}
+
+func networkingDefaultSecGroupRuleV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingDefaultSecGroupRuleV2CreateOpts) (*networkingDefaultSecGroupRuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "default_security_group_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Rule networkingDefaultSecGroupRuleV2 `json:"default_security_group_rule"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("default-security-group-rules"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Rule, nil
+}
+
+func networkingDefaultSecGroupRuleV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingDefaultSecGroupRuleV2, error) {
+	var res struct {
+		Rule networkingDefaultSecGroupRuleV2 `json:"default_security_group_rule"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("default-security-group-rules", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Rule, nil
+}
+
+func networkingDefaultSecGroupRuleV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("default-security-group-rules", id), nil)
+
+	return err
+}
@@ -57,3 +57,27 @@ func networkingFloatingIPV2StateRefreshFunc(ctx context.Context, client *gopherc
 		return fip, fip.Status, nil
 	}
 }
+
+func expandNetworkingFloatingIPV2PTR(v []any) dnsFloatingIPPTRV2SetOpts {
+	ptr := v[0].(map[string]any)
+
+	return dnsFloatingIPPTRV2SetOpts{
+		PTRDName:    ptr["ptrdname"].(string),
+		Description: ptr["description"].(string),
+		TTL:         ptr["ttl"].(int),
+	}
+}
+
+func flattenNetworkingFloatingIPV2PTR(ptr *dnsFloatingIPPTRV2) []map[string]any {
+	if ptr == nil || ptr.PTRDName == "" {
+		return nil
+	}
+
+	return []map[string]any{
+		{
+			"ptrdname":    ptr.PTRDName,
+			"description": ptr.Description,
+			"ttl":         ptr.TTL,
+		},
+	}
+}
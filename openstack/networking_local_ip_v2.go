@@ -0,0 +1,181 @@
+package openstack
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// networkingLocalIPV2 represents a Neutron Local IP.
+type networkingLocalIPV2 struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	ProjectID      string `json:"project_id"`
+	LocalPortID    string `json:"local_port_id"`
+	NetworkID      string `json:"network_id"`
+	LocalIPAddress string `json:"local_ip_address"`
+	IPMode         string `json:"ip_mode"`
+	RevisionNumber int    `json:"revision_number"`
+}
+
+type networkingLocalIPV2CreateOpts struct {
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	ProjectID      string `json:"project_id,omitempty"`
+	LocalPortID    string `json:"local_port_id,omitempty"`
+	NetworkID      string `json:"network_id,omitempty"`
+	LocalIPAddress string `json:"local_ip_address,omitempty"`
+	IPMode         string `json:"ip_mode,omitempty"`
+}
+
+type networkingLocalIPV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type networkingLocalIPV2ListOpts struct {
+	Name           string `q:"name"`
+	Description    string `q:"description"`
+	ProjectID      string `q:"project_id"`
+	LocalPortID    string `q:"local_port_id"`
+	NetworkID      string `q:"network_id"`
+	LocalIPAddress string `q:"local_ip_address"`
+	IPMode         string `q:"ip_mode"`
+}
+
+// networkingLocalIPAssociationV2 represents an association of a Neutron
+// Local IP with a port.
+type networkingLocalIPAssociationV2 struct {
+	LocalIPID      string `json:"local_ip_id"`
+	LocalIPAddress string `json:"local_ip_address"`
+	FixedPortID    string `json:"fixed_port_id"`
+	FixedIP        string `json:"fixed_ip"`
+	Host           string `json:"host"`
+}
+
+type networkingLocalIPAssociationV2CreateOpts struct {
+	FixedPortID string `json:"fixed_port_id" required:"true"`
+	FixedIP     string `json:"fixed_ip,omitempty"`
+}
+
+func networkingLocalIPV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingLocalIPV2CreateOpts) (*networkingLocalIPV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "local_ip")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		LocalIP networkingLocalIPV2 `json:"local_ip"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("local_ips"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.LocalIP, nil
+}
+
+func networkingLocalIPV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingLocalIPV2, error) {
+	var res struct {
+		LocalIP networkingLocalIPV2 `json:"local_ip"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("local_ips", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.LocalIP, nil
+}
+
+func networkingLocalIPV2List(ctx context.Context, client *gophercloud.ServiceClient, opts networkingLocalIPV2ListOpts) ([]networkingLocalIPV2, error) {
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAllPages[networkingLocalIPV2](ctx, client, client.ServiceURL("local_ips")+query.String(), "local_ips")
+}
+
+func networkingLocalIPV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts networkingLocalIPV2UpdateOpts) (*networkingLocalIPV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "local_ip")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		LocalIP networkingLocalIPV2 `json:"local_ip"`
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("local_ips", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.LocalIP, nil
+}
+
+func networkingLocalIPV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("local_ips", id), nil)
+
+	return err
+}
+
+func networkingLocalIPAssociationV2Create(ctx context.Context, client *gophercloud.ServiceClient, localIPID string, opts networkingLocalIPAssociationV2CreateOpts) (*networkingLocalIPAssociationV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "port_association")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Association networkingLocalIPAssociationV2 `json:"port_association"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("local_ips", localIPID, "port_associations"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Association, nil
+}
+
+// networkingLocalIPAssociationV2Get returns the association of a Local IP
+// with a port. Neutron can only list associations, so a missing association
+// is reported as a 404 error.
+func networkingLocalIPAssociationV2Get(ctx context.Context, client *gophercloud.ServiceClient, localIPID, fixedPortID string) (*networkingLocalIPAssociationV2, error) {
+	query, err := gophercloud.BuildQueryString(struct {
+		FixedPortID string `q:"fixed_port_id"`
+	}{fixedPortID})
+	if err != nil {
+		return nil, err
+	}
+
+	allAssociations, err := listAllPages[networkingLocalIPAssociationV2](ctx, client,
+		client.ServiceURL("local_ips", localIPID, "port_associations")+query.String(), "port_associations")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range allAssociations {
+		if a.FixedPortID == fixedPortID {
+			return &a, nil
+		}
+	}
+
+	return nil, gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusNotFound}
+}
+
+func networkingLocalIPAssociationV2Delete(ctx context.Context, client *gophercloud.ServiceClient, localIPID, fixedPortID string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("local_ips", localIPID, "port_associations", fixedPortID), nil)
+
+	return err
+}
@@ -0,0 +1,59 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitNetworkingLocalIPAssociationV2Get(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/local_ips/local_ip_1/port_associations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.URL.Query().Get("fixed_port_id") != "port_1" {
+			fmt.Fprint(w, `{"port_associations": []}`)
+
+			return
+		}
+
+		fmt.Fprint(w, `{
+  "port_associations": [
+    {
+      "local_ip_id": "local_ip_1",
+      "local_ip_address": "192.168.199.100",
+      "fixed_port_id": "port_1",
+      "fixed_ip": "192.168.199.10",
+      "host": "compute-1"
+    }
+  ]
+}`)
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	a, err := networkingLocalIPAssociationV2Get(t.Context(), client, "local_ip_1", "port_1")
+	require.NoError(t, err)
+
+	expected := &networkingLocalIPAssociationV2{
+		LocalIPID:      "local_ip_1",
+		LocalIPAddress: "192.168.199.100",
+		FixedPortID:    "port_1",
+		FixedIP:        "192.168.199.10",
+		Host:           "compute-1",
+	}
+	assert.Equal(t, expected, a)
+
+	_, err = networkingLocalIPAssociationV2Get(t.Context(), client, "local_ip_1", "port_2")
+	assert.True(t, gophercloud.ResponseCodeIs(err, http.StatusNotFound))
+}
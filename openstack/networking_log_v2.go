@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// networkingLogV2 represents a Neutron network log.
+type networkingLogV2 struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	ProjectID    string `json:"project_id"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	TargetID     string `json:"target_id"`
+	Event        string `json:"event"`
+	Enabled      bool   `json:"enabled"`
+}
+
+type networkingLogV2CreateOpts struct {
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	ProjectID    string `json:"project_id,omitempty"`
+	ResourceType string `json:"resource_type" required:"true"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	TargetID     string `json:"target_id,omitempty"`
+	Event        string `json:"event,omitempty"`
+	Enabled      *bool  `json:"enabled,omitempty"`
+}
+
+type networkingLogV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+func networkingLogV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingLogV2CreateOpts) (*networkingLogV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "log")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Log networkingLogV2 `json:"log"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("log", "logs"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Log, nil
+}
+
+func networkingLogV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingLogV2, error) {
+	var res struct {
+		Log networkingLogV2 `json:"log"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("log", "logs", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Log, nil
+}
+
+func networkingLogV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts networkingLogV2UpdateOpts) (*networkingLogV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "log")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Log networkingLogV2 `json:"log"`
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("log", "logs", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Log, nil
+}
+
+func networkingLogV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("log", "logs", id), nil)
+
+	return err
+}
+
+// networkingLogV2ListLoggableResources returns the resource types, which can
+// be logged.
+func networkingLogV2ListLoggableResources(ctx context.Context, client *gophercloud.ServiceClient) ([]string, error) {
+	type loggableResource struct {
+		Type string `json:"type"`
+	}
+
+	allResources, err := listAllPages[loggableResource](ctx, client, client.ServiceURL("log", "loggable-resources"), "loggable_resources")
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]string, len(allResources))
+	for i, r := range allResources {
+		types[i] = r.Type
+	}
+
+	return types, nil
+}
@@ -0,0 +1,90 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitNetworkingLogV2Create(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/log/logs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodPost)
+		th.TestJSONRequest(t, r, `{
+  "log": {
+    "name": "log_1",
+    "resource_type": "security_group",
+    "resource_id": "8c5f3bb5-5e48-4e59-8b0c-9b3d3b0a6a4b",
+    "event": "DROP",
+    "enabled": false
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+  "log": {
+    "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+    "name": "log_1",
+    "description": "",
+    "project_id": "92a5fd7a9e3a4d1f8f0e0c8b5c3a8e1d",
+    "resource_type": "security_group",
+    "resource_id": "8c5f3bb5-5e48-4e59-8b0c-9b3d3b0a6a4b",
+    "target_id": null,
+    "event": "DROP",
+    "enabled": false
+  }
+}`)
+	})
+
+	enabled := false
+	opts := networkingLogV2CreateOpts{
+		Name:         "log_1",
+		ResourceType: "security_group",
+		ResourceID:   "8c5f3bb5-5e48-4e59-8b0c-9b3d3b0a6a4b",
+		Event:        "DROP",
+		Enabled:      &enabled,
+	}
+
+	l, err := networkingLogV2Create(t.Context(), thclient.ServiceClient(fakeServer), opts)
+	require.NoError(t, err)
+
+	expected := &networkingLogV2{
+		ID:           "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+		Name:         "log_1",
+		ProjectID:    "92a5fd7a9e3a4d1f8f0e0c8b5c3a8e1d",
+		ResourceType: "security_group",
+		ResourceID:   "8c5f3bb5-5e48-4e59-8b0c-9b3d3b0a6a4b",
+		Event:        "DROP",
+		Enabled:      false,
+	}
+	assert.Equal(t, expected, l)
+}
+
+func TestUnitNetworkingLogV2ListLoggableResources(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/log/loggable-resources", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "loggable_resources": [
+    {"type": "security_group"},
+    {"type": "firewall_group"}
+  ]
+}`)
+	})
+
+	types, err := networkingLogV2ListLoggableResources(t.Context(), thclient.ServiceClient(fakeServer))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"security_group", "firewall_group"}, types)
+}
@@ -0,0 +1,121 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// networkingMeteringLabelV2 represents a Neutron metering label.
+type networkingMeteringLabelV2 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ProjectID   string `json:"project_id"`
+	Shared      bool   `json:"shared"`
+}
+
+type networkingMeteringLabelV2CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ProjectID   string `json:"project_id,omitempty"`
+	Shared      bool   `json:"shared,omitempty"`
+}
+
+// networkingMeteringLabelRuleV2 represents a Neutron metering label rule.
+type networkingMeteringLabelRuleV2 struct {
+	ID                  string `json:"id"`
+	MeteringLabelID     string `json:"metering_label_id"`
+	Direction           string `json:"direction"`
+	Excluded            bool   `json:"excluded"`
+	RemoteIPPrefix      string `json:"remote_ip_prefix"`
+	SourceIPPrefix      string `json:"source_ip_prefix"`
+	DestinationIPPrefix string `json:"destination_ip_prefix"`
+}
+
+type networkingMeteringLabelRuleV2CreateOpts struct {
+	MeteringLabelID     string `json:"metering_label_id" required:"true"`
+	Direction           string `json:"direction,omitempty"`
+	Excluded            bool   `json:"excluded,omitempty"`
+	RemoteIPPrefix      string `json:"remote_ip_prefix,omitempty"`
+	SourceIPPrefix      string `json:"source_ip_prefix,omitempty"`
+	DestinationIPPrefix string `json:"destination_ip_prefix,omitempty"`
+}
+
+func networkingMeteringLabelV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingMeteringLabelV2CreateOpts) (*networkingMeteringLabelV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "metering_label")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		MeteringLabel networkingMeteringLabelV2 `json:"metering_label"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("metering", "metering-labels"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.MeteringLabel, nil
+}
+
+func networkingMeteringLabelV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingMeteringLabelV2, error) {
+	var res struct {
+		MeteringLabel networkingMeteringLabelV2 `json:"metering_label"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("metering", "metering-labels", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.MeteringLabel, nil
+}
+
+func networkingMeteringLabelV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("metering", "metering-labels", id), nil)
+
+	return err
+}
+
+func networkingMeteringLabelRuleV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingMeteringLabelRuleV2CreateOpts) (*networkingMeteringLabelRuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "metering_label_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		MeteringLabelRule networkingMeteringLabelRuleV2 `json:"metering_label_rule"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("metering", "metering-label-rules"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.MeteringLabelRule, nil
+}
+
+func networkingMeteringLabelRuleV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingMeteringLabelRuleV2, error) {
+	var res struct {
+		MeteringLabelRule networkingMeteringLabelRuleV2 `json:"metering_label_rule"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("metering", "metering-label-rules", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.MeteringLabelRule, nil
+}
+
+func networkingMeteringLabelRuleV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("metering", "metering-label-rules", id), nil)
+
+	return err
+}
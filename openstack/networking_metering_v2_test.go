@@ -0,0 +1,62 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitNetworkingMeteringLabelRuleV2Create(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/metering/metering-label-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodPost)
+		th.TestJSONRequest(t, r, `{
+  "metering_label_rule": {
+    "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+    "direction": "egress",
+    "excluded": true,
+    "destination_ip_prefix": "10.0.0.0/8"
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+  "metering_label_rule": {
+    "id": "00e13b58-b4f2-4579-9c9c-7ac94615f9ae",
+    "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+    "direction": "egress",
+    "excluded": true,
+    "remote_ip_prefix": null,
+    "source_ip_prefix": null,
+    "destination_ip_prefix": "10.0.0.0/8"
+  }
+}`)
+	})
+
+	opts := networkingMeteringLabelRuleV2CreateOpts{
+		MeteringLabelID:     "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+		Direction:           "egress",
+		Excluded:            true,
+		DestinationIPPrefix: "10.0.0.0/8",
+	}
+
+	rule, err := networkingMeteringLabelRuleV2Create(t.Context(), thclient.ServiceClient(fakeServer), opts)
+	require.NoError(t, err)
+
+	expected := &networkingMeteringLabelRuleV2{
+		ID:                  "00e13b58-b4f2-4579-9c9c-7ac94615f9ae",
+		MeteringLabelID:     "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+		Direction:           "egress",
+		Excluded:            true,
+		DestinationIPPrefix: "10.0.0.0/8",
+	}
+	assert.Equal(t, expected, rule)
+}
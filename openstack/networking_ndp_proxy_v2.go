@@ -0,0 +1,109 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// networkingNDPProxyV2 represents a Neutron NDP proxy of a router.
+type networkingNDPProxyV2 struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	ProjectID      string `json:"project_id"`
+	RouterID       string `json:"router_id"`
+	PortID         string `json:"port_id"`
+	IPAddress      string `json:"ip_address"`
+	RevisionNumber int    `json:"revision_number"`
+}
+
+type networkingNDPProxyV2CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ProjectID   string `json:"project_id,omitempty"`
+	RouterID    string `json:"router_id" required:"true"`
+	PortID      string `json:"port_id" required:"true"`
+	IPAddress   string `json:"ip_address,omitempty"`
+}
+
+type networkingNDPProxyV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type networkingNDPProxyV2ListOpts struct {
+	Name      string `q:"name"`
+	ProjectID string `q:"project_id"`
+	RouterID  string `q:"router_id"`
+	PortID    string `q:"port_id"`
+	IPAddress string `q:"ip_address"`
+}
+
+func networkingNDPProxyV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingNDPProxyV2CreateOpts) (*networkingNDPProxyV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "ndp_proxy")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		NDPProxy networkingNDPProxyV2 `json:"ndp_proxy"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("ndp_proxies"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.NDPProxy, nil
+}
+
+func networkingNDPProxyV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingNDPProxyV2, error) {
+	var res struct {
+		NDPProxy networkingNDPProxyV2 `json:"ndp_proxy"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("ndp_proxies", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.NDPProxy, nil
+}
+
+func networkingNDPProxyV2List(ctx context.Context, client *gophercloud.ServiceClient, opts networkingNDPProxyV2ListOpts) ([]networkingNDPProxyV2, error) {
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAllPages[networkingNDPProxyV2](ctx, client, client.ServiceURL("ndp_proxies")+query.String(), "ndp_proxies")
+}
+
+func networkingNDPProxyV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts networkingNDPProxyV2UpdateOpts) (*networkingNDPProxyV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "ndp_proxy")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		NDPProxy networkingNDPProxyV2 `json:"ndp_proxy"`
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("ndp_proxies", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.NDPProxy, nil
+}
+
+func networkingNDPProxyV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("ndp_proxies", id), nil)
+
+	return err
+}
@@ -2,9 +2,11 @@ package openstack
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
@@ -121,3 +123,128 @@ func resourceNetworkingRouterInterfaceV2DeleteRefreshFunc(ctx context.Context, n
 		return "", "ACTIVE", nil
 	}
 }
+
+// resourceNetworkingRouterInterfaceV2CustomizeDiff validates at plan time,
+// that the CIDRs of the subnets to attach don't overlap with the subnets
+// already attached to the router. The validation is skipped, when the router
+// or the subnets are not known yet.
+func resourceNetworkingRouterInterfaceV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" || !d.NewValueKnown("router_id") {
+		return nil
+	}
+
+	var subnetID, portID string
+
+	switch {
+	case d.NewValueKnown("subnet_id") && d.Get("subnet_id").(string) != "":
+		subnetID = d.Get("subnet_id").(string)
+	case d.NewValueKnown("port_id") && d.Get("port_id").(string) != "":
+		portID = d.Get("port_id").(string)
+	default:
+		return nil
+	}
+
+	routerID := d.Get("router_id").(string)
+
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegionFromResourceDiff(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+	}
+
+	newSubnetIDs := []string{subnetID}
+
+	if subnetID == "" {
+		port, err := ports.Get(ctx, networkingClient, portID).Extract()
+		if err != nil {
+			log.Printf("[DEBUG] Unable to retrieve port %s to validate openstack_networking_router_interface_v2: %s", portID, err)
+
+			return nil
+		}
+
+		newSubnetIDs = networkingRouterInterfaceV2PortSubnetIDs(*port)
+	}
+
+	allPages, err := ports.List(networkingClient, ports.ListOpts{DeviceID: routerID}).AllPages(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to list ports of router %s to validate openstack_networking_router_interface_v2: %s", routerID, err)
+
+		return nil
+	}
+
+	routerPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to extract ports of router %s to validate openstack_networking_router_interface_v2: %s", routerID, err)
+
+		return nil
+	}
+
+	var attachedSubnetIDs []string
+
+	for _, port := range routerPorts {
+		if networkingRouterInterfaceV2IsInterfacePort(port) {
+			attachedSubnetIDs = append(attachedSubnetIDs, networkingRouterInterfaceV2PortSubnetIDs(port)...)
+		}
+	}
+
+	cidrs := make(map[string]string)
+
+	for _, id := range append(newSubnetIDs, attachedSubnetIDs...) {
+		if _, ok := cidrs[id]; ok {
+			continue
+		}
+
+		subnet, err := subnets.Get(ctx, networkingClient, id).Extract()
+		if err != nil {
+			log.Printf("[DEBUG] Unable to retrieve subnet %s to validate openstack_networking_router_interface_v2: %s", id, err)
+
+			return nil
+		}
+
+		cidrs[id] = subnet.CIDR
+	}
+
+	return networkingRouterInterfaceV2ValidateOverlap(routerID, newSubnetIDs, attachedSubnetIDs, cidrs)
+}
+
+func networkingRouterInterfaceV2IsInterfacePort(port ports.Port) bool {
+	return strings.HasPrefix(port.DeviceOwner, "network:router_interface") ||
+		port.DeviceOwner == "network:ha_router_replicated_interface"
+}
+
+func networkingRouterInterfaceV2PortSubnetIDs(port ports.Port) []string {
+	subnetIDs := make([]string, 0, len(port.FixedIPs))
+	for _, ip := range port.FixedIPs {
+		subnetIDs = append(subnetIDs, ip.SubnetID)
+	}
+
+	return subnetIDs
+}
+
+func networkingRouterInterfaceV2ValidateOverlap(routerID string, newSubnetIDs, attachedSubnetIDs []string, cidrs map[string]string) error {
+	for _, newID := range newSubnetIDs {
+		_, newCIDR, err := net.ParseCIDR(cidrs[newID])
+		if err != nil {
+			continue
+		}
+
+		for _, attachedID := range attachedSubnetIDs {
+			if attachedID == newID {
+				continue
+			}
+
+			_, attachedCIDR, err := net.ParseCIDR(cidrs[attachedID])
+			if err != nil {
+				continue
+			}
+
+			if networkingV2CIDRsOverlap(newCIDR, attachedCIDR) {
+				return fmt.Errorf("cidr %s of subnet %s overlaps with cidr %s of subnet %s, which is already attached to router %s",
+					newCIDR, newID, attachedCIDR, attachedID, routerID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitNetworkingRouterInterfaceV2ValidateOverlap(t *testing.T) {
+	cidrs := map[string]string{
+		"subnet_1": "192.168.1.0/24",
+		"subnet_2": "192.168.2.0/24",
+		"subnet_3": "192.168.0.0/16",
+	}
+
+	require.NoError(t, networkingRouterInterfaceV2ValidateOverlap("router_1", []string{"subnet_2"}, []string{"subnet_1"}, cidrs))
+	require.NoError(t, networkingRouterInterfaceV2ValidateOverlap("router_1", []string{"subnet_1"}, []string{"subnet_1"}, cidrs))
+	require.EqualError(t,
+		networkingRouterInterfaceV2ValidateOverlap("router_1", []string{"subnet_3"}, []string{"subnet_1", "subnet_2"}, cidrs),
+		"cidr 192.168.0.0/16 of subnet subnet_3 overlaps with cidr 192.168.1.0/24 of subnet subnet_1, which is already attached to router router_1")
+}
+
+func TestUnitNetworkingRouterInterfaceV2IsInterfacePort(t *testing.T) {
+	assert.True(t, networkingRouterInterfaceV2IsInterfacePort(ports.Port{DeviceOwner: "network:router_interface"}))
+	assert.True(t, networkingRouterInterfaceV2IsInterfacePort(ports.Port{DeviceOwner: "network:router_interface_distributed"}))
+	assert.True(t, networkingRouterInterfaceV2IsInterfacePort(ports.Port{DeviceOwner: "network:ha_router_replicated_interface"}))
+	assert.False(t, networkingRouterInterfaceV2IsInterfacePort(ports.Port{DeviceOwner: "network:router_gateway"}))
+}
@@ -4,19 +4,28 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"slices"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type RouterFlavor struct {
 	FlavorID string `json:"flavor_id,omitempty"`
 }
 
+// RouterExternalGateways holds the gateways of the external-gateway-multihoming
+// extension, which are not part of the gophercloud router.
+type RouterExternalGateways struct {
+	ExternalGateways []routers.GatewayInfo `json:"external_gateways,omitempty"`
+}
+
 type routerExtended struct {
 	routers.Router
 	RouterFlavor
+	RouterExternalGateways
 }
 
 type routerListOpts struct {
@@ -95,3 +104,138 @@ func flattenNetworkingRouterExternalFixedIPsV2(externalFixedIPs []routers.Extern
 
 	return fixedIPs
 }
+
+func expandNetworkingRouterExternalGatewaysV2(rawGateways []any) []routers.GatewayInfo {
+	gateways := make([]routers.GatewayInfo, len(rawGateways))
+
+	for i, raw := range rawGateways {
+		rawMap := raw.(map[string]any)
+		enableSNAT := rawMap["enable_snat"].(bool)
+
+		gateways[i] = routers.GatewayInfo{
+			NetworkID:        rawMap["network_id"].(string),
+			EnableSNAT:       &enableSNAT,
+			QoSPolicyID:      rawMap["qos_policy_id"].(string),
+			ExternalFixedIPs: expandNetworkingRouterExternalFixedIPsV2(rawMap["external_fixed_ip"].([]any)),
+		}
+	}
+
+	return gateways
+}
+
+// networkingRouterV2ConfiguredExternalGateways returns the desired external
+// gateways. enable_snat is only sent for the gateways, which set it
+// explicitly, because it's restricted to admins by the default Neutron policy.
+func networkingRouterV2ConfiguredExternalGateways(d *schema.ResourceData) []routers.GatewayInfo {
+	gateways := expandNetworkingRouterExternalGatewaysV2(d.Get("external_gateway").([]any))
+
+	var snatConfigured []bool
+
+	if v := d.GetRawConfig().GetAttr("external_gateway"); v.IsKnown() && !v.IsNull() {
+		for it := v.ElementIterator(); it.Next(); {
+			_, gw := it.Element()
+			snatConfigured = append(snatConfigured, !gw.GetAttr("enable_snat").IsNull())
+		}
+	}
+
+	for i := range gateways {
+		if i >= len(snatConfigured) || !snatConfigured[i] {
+			gateways[i].EnableSNAT = nil
+		}
+	}
+
+	return gateways
+}
+
+func flattenNetworkingRouterExternalGatewaysV2(router routerExtended) []map[string]any {
+	gateways := router.ExternalGateways
+	if len(gateways) == 0 && router.GatewayInfo.NetworkID != "" {
+		// The external-gateway-multihoming extension is not available.
+		gateways = []routers.GatewayInfo{router.GatewayInfo}
+	}
+
+	res := make([]map[string]any, len(gateways))
+
+	for i, gw := range gateways {
+		res[i] = map[string]any{
+			"network_id":        gw.NetworkID,
+			"qos_policy_id":     gw.QoSPolicyID,
+			"external_fixed_ip": flattenNetworkingRouterExternalFixedIPsV2(gw.ExternalFixedIPs),
+		}
+
+		if gw.EnableSNAT != nil {
+			res[i]["enable_snat"] = *gw.EnableSNAT
+		}
+	}
+
+	return res
+}
+
+// networkingRouterV2ExternalGatewaysDiff compares the current and the desired
+// external gateways of a router. Gateways are matched by their network and
+// their position among the gateways of the same network. Gateways with
+// changed fixed IPs are replaced, because Neutron identifies the gateway
+// ports to update by their fixed IPs.
+func networkingRouterV2ExternalGatewaysDiff(current, desired []routers.GatewayInfo) ([]routers.GatewayInfo, []routers.GatewayInfo, []routers.GatewayInfo) {
+	var toAdd, toUpdate, toRemove []routers.GatewayInfo
+
+	currentByNetwork := make(map[string][]routers.GatewayInfo)
+	for _, gw := range current {
+		currentByNetwork[gw.NetworkID] = append(currentByNetwork[gw.NetworkID], gw)
+	}
+
+	seen := make(map[string]int)
+
+	for _, gw := range desired {
+		i := seen[gw.NetworkID]
+		seen[gw.NetworkID]++
+
+		if i >= len(currentByNetwork[gw.NetworkID]) {
+			toAdd = append(toAdd, gw)
+
+			continue
+		}
+
+		old := currentByNetwork[gw.NetworkID][i]
+
+		if len(gw.ExternalFixedIPs) > 0 && !networkingRouterV2ExternalFixedIPsEqual(old.ExternalFixedIPs, gw.ExternalFixedIPs) {
+			toRemove = append(toRemove, networkingRouterV2ExternalGatewayIdentity(old))
+			toAdd = append(toAdd, gw)
+
+			continue
+		}
+
+		snatChanged := gw.EnableSNAT != nil && (old.EnableSNAT == nil || *old.EnableSNAT != *gw.EnableSNAT)
+		if snatChanged || old.QoSPolicyID != gw.QoSPolicyID {
+			gw.ExternalFixedIPs = old.ExternalFixedIPs
+			toUpdate = append(toUpdate, gw)
+		}
+	}
+
+	kept := make(map[string]int)
+
+	for _, gw := range current {
+		if kept[gw.NetworkID] >= seen[gw.NetworkID] {
+			toRemove = append(toRemove, networkingRouterV2ExternalGatewayIdentity(gw))
+		}
+
+		kept[gw.NetworkID]++
+	}
+
+	return toAdd, toUpdate, toRemove
+}
+
+// networkingRouterV2ExternalGatewayIdentity returns the attributes, which
+// Neutron uses to identify a gateway port.
+func networkingRouterV2ExternalGatewayIdentity(gw routers.GatewayInfo) routers.GatewayInfo {
+	return routers.GatewayInfo{
+		NetworkID:        gw.NetworkID,
+		ExternalFixedIPs: gw.ExternalFixedIPs,
+	}
+}
+
+func networkingRouterV2ExternalFixedIPsEqual(a, b []routers.ExternalFixedIP) bool {
+	return slices.EqualFunc(a, b, func(x, y routers.ExternalFixedIP) bool {
+		return x.SubnetID == y.SubnetID && (x.IPAddress == y.IPAddress || x.IPAddress == "" || y.IPAddress == "")
+	})
+}
@@ -83,3 +83,118 @@ func TestUnitBuildRouterListOptsV2(t *testing.T) {
 	expectedQuery := "?flavor_id=flavor-123&name=test-router"
 	assert.Equal(t, expectedQuery, query)
 }
+
+func TestUnitNetworkingRouterV2ExternalGatewaysDiff(t *testing.T) {
+	enableSNAT := true
+	disableSNAT := false
+
+	current := []routers.GatewayInfo{
+		{
+			NetworkID:        "network_1",
+			EnableSNAT:       &enableSNAT,
+			ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_1", IPAddress: "192.168.101.10"}},
+		},
+		{
+			NetworkID:        "network_2",
+			EnableSNAT:       &enableSNAT,
+			ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_2", IPAddress: "192.168.201.10"}},
+		},
+		{
+			NetworkID:        "network_3",
+			EnableSNAT:       &enableSNAT,
+			ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_3", IPAddress: "192.168.31.10"}},
+		},
+	}
+
+	desired := []routers.GatewayInfo{
+		// unchanged, the IP address is computed
+		{
+			NetworkID:        "network_1",
+			ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_1"}},
+		},
+		// SNAT disabled
+		{
+			NetworkID:  "network_2",
+			EnableSNAT: &disableSNAT,
+		},
+		// additional gateway in the same network
+		{
+			NetworkID: "network_1",
+		},
+	}
+
+	toAdd, toUpdate, toRemove := networkingRouterV2ExternalGatewaysDiff(current, desired)
+
+	assert.Equal(t, []routers.GatewayInfo{{NetworkID: "network_1"}}, toAdd)
+	assert.Equal(t, []routers.GatewayInfo{
+		{
+			NetworkID:        "network_2",
+			EnableSNAT:       &disableSNAT,
+			ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_2", IPAddress: "192.168.201.10"}},
+		},
+	}, toUpdate)
+	assert.Equal(t, []routers.GatewayInfo{
+		{
+			NetworkID:        "network_3",
+			ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_3", IPAddress: "192.168.31.10"}},
+		},
+	}, toRemove)
+}
+
+func TestUnitNetworkingRouterV2ExternalGatewaysDiffFixedIPChange(t *testing.T) {
+	current := []routers.GatewayInfo{
+		{
+			NetworkID:        "network_1",
+			ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_1", IPAddress: "192.168.101.10"}},
+		},
+	}
+
+	desired := []routers.GatewayInfo{
+		{
+			NetworkID:        "network_1",
+			ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_2"}},
+		},
+	}
+
+	toAdd, toUpdate, toRemove := networkingRouterV2ExternalGatewaysDiff(current, desired)
+
+	assert.Equal(t, desired, toAdd)
+	assert.Empty(t, toUpdate)
+	assert.Equal(t, current, toRemove)
+}
+
+func TestUnitFlattenNetworkingRouterExternalGatewaysV2(t *testing.T) {
+	enableSNAT := true
+
+	var router routerExtended
+	router.GatewayInfo = routers.GatewayInfo{
+		NetworkID:        "network_1",
+		EnableSNAT:       &enableSNAT,
+		ExternalFixedIPs: []routers.ExternalFixedIP{{SubnetID: "subnet_1", IPAddress: "192.168.101.10"}},
+	}
+
+	expected := []map[string]any{
+		{
+			"network_id":    "network_1",
+			"enable_snat":   true,
+			"qos_policy_id": "",
+			"external_fixed_ip": []map[string]string{
+				{"subnet_id": "subnet_1", "ip_address": "192.168.101.10"},
+			},
+		},
+	}
+
+	// Without the external-gateway-multihoming extension the single gateway
+	// is reported.
+	assert.Equal(t, expected, flattenNetworkingRouterExternalGatewaysV2(router))
+
+	router.ExternalGateways = []routers.GatewayInfo{
+		router.GatewayInfo,
+		{NetworkID: "network_2"},
+	}
+
+	actual := flattenNetworkingRouterExternalGatewaysV2(router)
+	require.Len(t, actual, 2)
+	assert.Equal(t, expected[0], actual[0])
+	assert.Equal(t, "network_2", actual[1]["network_id"])
+}
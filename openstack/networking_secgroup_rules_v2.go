@@ -0,0 +1,286 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/terraform-provider-openstack/utils/v2/hashcode"
+)
+
+// networkingSecGroupRulesV2ProtocolNumbers maps the protocol names accepted by
+// Neutron to their IANA numbers.
+var networkingSecGroupRulesV2ProtocolNumbers = map[string]int{
+	string(rules.ProtocolAH):        51,
+	string(rules.ProtocolDCCP):      33,
+	string(rules.ProtocolEGP):       8,
+	string(rules.ProtocolESP):       50,
+	string(rules.ProtocolGRE):       47,
+	string(rules.ProtocolICMP):      1,
+	string(rules.ProtocolIGMP):      2,
+	string(rules.ProtocolIPIP):      4,
+	string(rules.ProtocolIPv6Encap): 41,
+	string(rules.ProtocolIPv6Frag):  44,
+	string(rules.ProtocolIPv6ICMP):  58,
+	string(rules.ProtocolIPv6NoNxt): 59,
+	string(rules.ProtocolIPv6Opts):  60,
+	string(rules.ProtocolIPv6Route): 43,
+	string(rules.ProtocolOSPF):      89,
+	string(rules.ProtocolPGM):       113,
+	string(rules.ProtocolRSVP):      46,
+	string(rules.ProtocolSCTP):      132,
+	string(rules.ProtocolTCP):       6,
+	string(rules.ProtocolUDP):       17,
+	string(rules.ProtocolUDPLite):   136,
+	string(rules.ProtocolVRRP):      112,
+	"icmpv6":                        58,
+}
+
+// networkingSecGroupRulesV2NormalizeProtocol returns the protocol number of a
+// protocol name or number. An empty string is returned for any protocol.
+func networkingSecGroupRulesV2NormalizeProtocol(protocol string) string {
+	protocol = strings.ToLower(strings.TrimSpace(protocol))
+	if protocol == "" || protocol == "any" {
+		return ""
+	}
+
+	if n, ok := networkingSecGroupRulesV2ProtocolNumbers[protocol]; ok {
+		return strconv.Itoa(n)
+	}
+
+	if n, err := strconv.Atoi(protocol); err == nil {
+		return strconv.Itoa(n)
+	}
+
+	return protocol
+}
+
+// networkingSecGroupRulesV2NormalizeRemoteIPPrefix returns the canonical form
+// of a remote IP prefix. Prefixes matching any address are equivalent to no
+// prefix.
+func networkingSecGroupRulesV2NormalizeRemoteIPPrefix(prefix string) string {
+	if prefix == "" {
+		return ""
+	}
+
+	_, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		ip := net.ParseIP(prefix)
+		if ip == nil {
+			return strings.ToLower(prefix)
+		}
+
+		bits := 32
+		if ip.To4() == nil {
+			bits = 128
+		}
+
+		ipNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	}
+
+	if ones, _ := ipNet.Mask.Size(); ones == 0 {
+		return ""
+	}
+
+	return ipNet.String()
+}
+
+// networkingSecGroupRulesV2Key returns a key, which is equal for all rules
+// Neutron considers to be the same. Like Neutron's duplicate check, it
+// ignores the description.
+func networkingSecGroupRulesV2Key(rule map[string]any) string {
+	etherType, _ := rule["ethertype"].(string)
+	if etherType == "" {
+		etherType = string(rules.EtherType4)
+	}
+
+	protocol := networkingSecGroupRulesV2NormalizeProtocol(rule["protocol"].(string))
+	if protocol == "1" && etherType == string(rules.EtherType6) {
+		// Neutron treats icmp in IPv6 rules as ipv6-icmp.
+		protocol = "58"
+	}
+
+	return strings.Join([]string{
+		strings.ToLower(rule["direction"].(string)),
+		etherType,
+		protocol,
+		strconv.Itoa(rule["port_range_min"].(int)),
+		strconv.Itoa(rule["port_range_max"].(int)),
+		networkingSecGroupRulesV2NormalizeRemoteIPPrefix(rule["remote_ip_prefix"].(string)),
+		rule["remote_group_id"].(string),
+		rule["remote_address_group_id"].(string),
+	}, "|")
+}
+
+// networkingSecGroupRulesV2Hash includes the description, so that a changed
+// description is planned as a replaced rule.
+func networkingSecGroupRulesV2Hash(v any) int {
+	rule := v.(map[string]any)
+
+	return hashcode.String(networkingSecGroupRulesV2Key(rule) + "|" + rule["description"].(string))
+}
+
+func flattenNetworkingSecGroupRulesV2Rule(rule rules.SecGroupRule) map[string]any {
+	return map[string]any{
+		"direction":               rule.Direction,
+		"ethertype":               rule.EtherType,
+		"protocol":                rule.Protocol,
+		"port_range_min":          rule.PortRangeMin,
+		"port_range_max":          rule.PortRangeMax,
+		"remote_ip_prefix":        rule.RemoteIPPrefix,
+		"remote_group_id":         rule.RemoteGroupID,
+		"remote_address_group_id": rule.RemoteAddressGroupID,
+		"description":             rule.Description,
+	}
+}
+
+// flattenNetworkingSecGroupRulesV2 converts the actual rules of a security
+// group. Rules matching a configured rule keep their configured notation, so
+// that e.g. protocol numbers and names don't cause a diff. The actual
+// description is always kept, so that a changed description is detected.
+func flattenNetworkingSecGroupRulesV2(actual []rules.SecGroupRule, configured []any) []map[string]any {
+	known := make(map[string]map[string]any, len(configured))
+
+	for _, raw := range configured {
+		rule := raw.(map[string]any)
+		known[networkingSecGroupRulesV2Key(rule)] = rule
+	}
+
+	res := make([]map[string]any, 0, len(actual))
+
+	for _, r := range actual {
+		rule := flattenNetworkingSecGroupRulesV2Rule(r)
+		if v, ok := known[networkingSecGroupRulesV2Key(rule)]; ok {
+			description := rule["description"]
+			rule = make(map[string]any, len(v))
+
+			for k, val := range v {
+				rule[k] = val
+			}
+
+			rule["description"] = description
+		}
+
+		res = append(res, rule)
+	}
+
+	return res
+}
+
+func expandNetworkingSecGroupRulesV2CreateOpts(securityGroupID string, rule map[string]any) (rules.CreateOpts, error) {
+	var remotes int
+
+	for _, k := range []string{"remote_ip_prefix", "remote_group_id", "remote_address_group_id"} {
+		if rule[k].(string) != "" {
+			remotes++
+		}
+	}
+
+	if remotes > 1 {
+		return rules.CreateOpts{}, errors.New("only one of remote_ip_prefix, remote_group_id and remote_address_group_id can be set")
+	}
+
+	portRangeMin := rule["port_range_min"].(int)
+	portRangeMax := rule["port_range_max"].(int)
+	protocol := rule["protocol"].(string)
+
+	if (portRangeMin != 0 || portRangeMax != 0) && protocol == "" {
+		return rules.CreateOpts{}, errors.New("protocol must be set when port_range_min or port_range_max are set")
+	}
+
+	etherType := rule["ethertype"].(string)
+	if etherType == "" {
+		etherType = string(rules.EtherType4)
+	}
+
+	return rules.CreateOpts{
+		Direction:            rules.RuleDirection(rule["direction"].(string)),
+		EtherType:            rules.RuleEtherType(etherType),
+		Protocol:             rules.RuleProtocol(protocol),
+		PortRangeMin:         portRangeMin,
+		PortRangeMax:         portRangeMax,
+		Description:          rule["description"].(string),
+		SecGroupID:           securityGroupID,
+		RemoteGroupID:        rule["remote_group_id"].(string),
+		RemoteIPPrefix:       rule["remote_ip_prefix"].(string),
+		RemoteAddressGroupID: rule["remote_address_group_id"].(string),
+	}, nil
+}
+
+func networkingSecGroupRulesV2List(ctx context.Context, client *gophercloud.ServiceClient, securityGroupID string) ([]rules.SecGroupRule, error) {
+	allPages, err := rules.List(client, rules.ListOpts{SecGroupID: securityGroupID}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing rules of security group %s: %w", securityGroupID, err)
+	}
+
+	allRules, err := rules.ExtractRules(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Error extracting rules of security group %s: %w", securityGroupID, err)
+	}
+
+	return allRules, nil
+}
+
+// networkingSecGroupRulesV2Replacement is an actual rule, which only differs
+// from its configured rule by the description. Neutron can't update rules and
+// rejects a second rule, which only differs by the description, so the old
+// rule has to be deleted before the new one is created.
+type networkingSecGroupRulesV2Replacement struct {
+	Old rules.SecGroupRule
+	New map[string]any
+}
+
+// networkingSecGroupRulesV2Changes holds the operations needed to bring the
+// rules of a security group in line with the configuration.
+type networkingSecGroupRulesV2Changes struct {
+	Create  []map[string]any
+	Replace []networkingSecGroupRulesV2Replacement
+	Delete  []rules.SecGroupRule
+}
+
+// networkingSecGroupRulesV2Diff returns the configured rules, which don't
+// exist yet, the rules whose description changed and the actual rules, which
+// are not configured.
+func networkingSecGroupRulesV2Diff(actual []rules.SecGroupRule, configured []any) (networkingSecGroupRulesV2Changes, error) {
+	var changes networkingSecGroupRulesV2Changes
+
+	actualByKey := make(map[string]rules.SecGroupRule, len(actual))
+	for _, r := range actual {
+		actualByKey[networkingSecGroupRulesV2Key(flattenNetworkingSecGroupRulesV2Rule(r))] = r
+	}
+
+	configuredKeys := make(map[string]bool, len(configured))
+
+	for _, raw := range configured {
+		rule := raw.(map[string]any)
+		key := networkingSecGroupRulesV2Key(rule)
+
+		if configuredKeys[key] {
+			return changes, fmt.Errorf("duplicate rule %s: rules, which only differ by their description, are the same for Neutron", key)
+		}
+
+		configuredKeys[key] = true
+
+		r, ok := actualByKey[key]
+
+		switch {
+		case !ok:
+			changes.Create = append(changes.Create, rule)
+		case r.Description != rule["description"].(string):
+			changes.Replace = append(changes.Replace, networkingSecGroupRulesV2Replacement{Old: r, New: rule})
+		}
+	}
+
+	for _, r := range actual {
+		if !configuredKeys[networkingSecGroupRulesV2Key(flattenNetworkingSecGroupRulesV2Rule(r))] {
+			changes.Delete = append(changes.Delete, r)
+		}
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,147 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNetworkingSecGroupRulesV2Rule(direction, etherType, protocol string, portMin, portMax int, prefix string) map[string]any {
+	return map[string]any{
+		"direction":               direction,
+		"ethertype":               etherType,
+		"protocol":                protocol,
+		"port_range_min":          portMin,
+		"port_range_max":          portMax,
+		"remote_ip_prefix":        prefix,
+		"remote_group_id":         "",
+		"remote_address_group_id": "",
+		"description":             "",
+	}
+}
+
+func TestUnitNetworkingSecGroupRulesV2NormalizeProtocol(t *testing.T) {
+	assert.Empty(t, networkingSecGroupRulesV2NormalizeProtocol(""))
+	assert.Empty(t, networkingSecGroupRulesV2NormalizeProtocol("any"))
+	assert.Equal(t, "6", networkingSecGroupRulesV2NormalizeProtocol("tcp"))
+	assert.Equal(t, "6", networkingSecGroupRulesV2NormalizeProtocol("TCP"))
+	assert.Equal(t, "6", networkingSecGroupRulesV2NormalizeProtocol("06"))
+	assert.Equal(t, "58", networkingSecGroupRulesV2NormalizeProtocol("ipv6-icmp"))
+	assert.Equal(t, "58", networkingSecGroupRulesV2NormalizeProtocol("icmpv6"))
+}
+
+func TestUnitNetworkingSecGroupRulesV2NormalizeRemoteIPPrefix(t *testing.T) {
+	assert.Empty(t, networkingSecGroupRulesV2NormalizeRemoteIPPrefix(""))
+	assert.Empty(t, networkingSecGroupRulesV2NormalizeRemoteIPPrefix("0.0.0.0/0"))
+	assert.Empty(t, networkingSecGroupRulesV2NormalizeRemoteIPPrefix("::/0"))
+	assert.Equal(t, "10.0.0.0/8", networkingSecGroupRulesV2NormalizeRemoteIPPrefix("10.1.2.3/8"))
+	assert.Equal(t, "192.168.1.1/32", networkingSecGroupRulesV2NormalizeRemoteIPPrefix("192.168.1.1"))
+	assert.Equal(t, "2001:558:fc00::/39", networkingSecGroupRulesV2NormalizeRemoteIPPrefix("2001:558:FC00::/39"))
+}
+
+func TestUnitNetworkingSecGroupRulesV2Key(t *testing.T) {
+	assert.Equal(t,
+		networkingSecGroupRulesV2Key(testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 22, 22, "0.0.0.0/0")),
+		networkingSecGroupRulesV2Key(testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "6", 22, 22, "")),
+	)
+	assert.Equal(t,
+		networkingSecGroupRulesV2Key(testNetworkingSecGroupRulesV2Rule("ingress", "IPv6", "icmp", 0, 0, "")),
+		networkingSecGroupRulesV2Key(testNetworkingSecGroupRulesV2Rule("ingress", "IPv6", "ipv6-icmp", 0, 0, "")),
+	)
+	assert.NotEqual(t,
+		networkingSecGroupRulesV2Key(testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 22, 22, "")),
+		networkingSecGroupRulesV2Key(testNetworkingSecGroupRulesV2Rule("egress", "IPv4", "tcp", 22, 22, "")),
+	)
+	assert.NotEqual(t,
+		networkingSecGroupRulesV2Key(testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 22, 22, "")),
+		networkingSecGroupRulesV2Key(testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "udp", 22, 22, "")),
+	)
+}
+
+func TestUnitNetworkingSecGroupRulesV2Diff(t *testing.T) {
+	actual := []rules.SecGroupRule{
+		{ID: "1", Direction: "ingress", EtherType: "IPv4", Protocol: "6", PortRangeMin: 22, PortRangeMax: 22},
+		{ID: "2", Direction: "egress", EtherType: "IPv4"},
+	}
+
+	configured := []any{
+		testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 22, 22, "0.0.0.0/0"),
+		testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 443, 443, ""),
+	}
+
+	changes, err := networkingSecGroupRulesV2Diff(actual, configured)
+	require.NoError(t, err)
+
+	require.Len(t, changes.Create, 1)
+	assert.Equal(t, 443, changes.Create[0]["port_range_min"])
+	assert.Empty(t, changes.Replace)
+	require.Len(t, changes.Delete, 1)
+	assert.Equal(t, "2", changes.Delete[0].ID)
+}
+
+func TestUnitNetworkingSecGroupRulesV2DiffDescription(t *testing.T) {
+	actual := []rules.SecGroupRule{
+		{ID: "1", Direction: "ingress", EtherType: "IPv4", Protocol: "6", PortRangeMin: 22, PortRangeMax: 22, Description: "ssh"},
+	}
+
+	rule := testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 22, 22, "")
+	rule["description"] = "ssh from anywhere"
+
+	changes, err := networkingSecGroupRulesV2Diff(actual, []any{rule})
+	require.NoError(t, err)
+
+	assert.Empty(t, changes.Create)
+	assert.Empty(t, changes.Delete)
+	require.Len(t, changes.Replace, 1)
+	assert.Equal(t, "1", changes.Replace[0].Old.ID)
+	assert.Equal(t, "ssh from anywhere", changes.Replace[0].New["description"])
+
+	duplicate := testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 22, 22, "")
+
+	_, err = networkingSecGroupRulesV2Diff(actual, []any{rule, duplicate})
+	require.Error(t, err)
+}
+
+func TestUnitFlattenNetworkingSecGroupRulesV2(t *testing.T) {
+	actual := []rules.SecGroupRule{
+		{ID: "1", Direction: "ingress", EtherType: "IPv4", Protocol: "6", PortRangeMin: 22, PortRangeMax: 22},
+		{ID: "2", Direction: "egress", EtherType: "IPv4"},
+	}
+
+	configured := []any{
+		testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 22, 22, "0.0.0.0/0"),
+	}
+
+	expected := []map[string]any{
+		testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 22, 22, "0.0.0.0/0"),
+		testNetworkingSecGroupRulesV2Rule("egress", "IPv4", "", 0, 0, ""),
+	}
+
+	assert.Equal(t, expected, flattenNetworkingSecGroupRulesV2(actual, configured))
+}
+
+func TestUnitExpandNetworkingSecGroupRulesV2CreateOpts(t *testing.T) {
+	rule := testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "tcp", 22, 22, "10.0.0.0/8")
+
+	opts, err := expandNetworkingSecGroupRulesV2CreateOpts("sg", rule)
+	require.NoError(t, err)
+	assert.Equal(t, rules.CreateOpts{
+		Direction:      rules.DirIngress,
+		EtherType:      rules.EtherType4,
+		Protocol:       rules.ProtocolTCP,
+		PortRangeMin:   22,
+		PortRangeMax:   22,
+		SecGroupID:     "sg",
+		RemoteIPPrefix: "10.0.0.0/8",
+	}, opts)
+
+	rule["remote_group_id"] = "other"
+	_, err = expandNetworkingSecGroupRulesV2CreateOpts("sg", rule)
+	require.Error(t, err)
+
+	rule = testNetworkingSecGroupRulesV2Rule("ingress", "IPv4", "", 22, 22, "")
+	_, err = expandNetworkingSecGroupRulesV2CreateOpts("sg", rule)
+	require.Error(t, err)
+}
@@ -0,0 +1,171 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// networkingSegmentRangeV2 represents a Neutron network segment range.
+type networkingSegmentRangeV2 struct {
+	ID              string            `json:"id"`
+	Name            string            `json:"name"`
+	Default         bool              `json:"default"`
+	Shared          bool              `json:"shared"`
+	ProjectID       string            `json:"project_id"`
+	NetworkType     string            `json:"network_type"`
+	PhysicalNetwork string            `json:"physical_network"`
+	Minimum         int               `json:"minimum"`
+	Maximum         int               `json:"maximum"`
+	Available       []int             `json:"available"`
+	Used            map[string]string `json:"used"`
+	RevisionNumber  int               `json:"revision_number"`
+}
+
+type networkingSegmentRangeV2CreateOpts struct {
+	Name            string `json:"name,omitempty"`
+	Shared          *bool  `json:"shared,omitempty"`
+	ProjectID       string `json:"project_id,omitempty"`
+	NetworkType     string `json:"network_type" required:"true"`
+	PhysicalNetwork string `json:"physical_network,omitempty"`
+	Minimum         int    `json:"minimum" required:"true"`
+	Maximum         int    `json:"maximum" required:"true"`
+}
+
+type networkingSegmentRangeV2UpdateOpts struct {
+	Name    *string `json:"name,omitempty"`
+	Minimum *int    `json:"minimum,omitempty"`
+	Maximum *int    `json:"maximum,omitempty"`
+}
+
+type networkingSegmentRangeV2ListOpts struct {
+	Name            string `q:"name"`
+	NetworkType     string `q:"network_type"`
+	PhysicalNetwork string `q:"physical_network"`
+	ProjectID       string `q:"project_id"`
+}
+
+func networkingSegmentRangeV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingSegmentRangeV2CreateOpts) (*networkingSegmentRangeV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "network_segment_range")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		SegmentRange networkingSegmentRangeV2 `json:"network_segment_range"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("network_segment_ranges"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.SegmentRange, nil
+}
+
+func networkingSegmentRangeV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingSegmentRangeV2, error) {
+	var res struct {
+		SegmentRange networkingSegmentRangeV2 `json:"network_segment_range"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("network_segment_ranges", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.SegmentRange, nil
+}
+
+func networkingSegmentRangeV2List(ctx context.Context, client *gophercloud.ServiceClient, opts networkingSegmentRangeV2ListOpts) ([]networkingSegmentRangeV2, error) {
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAllPages[networkingSegmentRangeV2](ctx, client, client.ServiceURL("network_segment_ranges")+query.String(), "network_segment_ranges")
+}
+
+func networkingSegmentRangeV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts networkingSegmentRangeV2UpdateOpts) (*networkingSegmentRangeV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "network_segment_range")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		SegmentRange networkingSegmentRangeV2 `json:"network_segment_range"`
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("network_segment_ranges", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.SegmentRange, nil
+}
+
+func networkingSegmentRangeV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("network_segment_ranges", id), nil)
+
+	return err
+}
+
+// networkingSegmentRangeV2ValidateProject checks that a project is set
+// exactly for a non-shared segment range, as required by Neutron.
+func networkingSegmentRangeV2ValidateProject(shared, hasProjectID bool) error {
+	if shared && hasProjectID {
+		return errors.New("project_id can't be set for a shared segment range")
+	}
+
+	if !shared && !hasProjectID {
+		return errors.New("project_id is required for a segment range, which is not shared")
+	}
+
+	return nil
+}
+
+// networkingSegmentRangeV2ValidateRange checks that minimum is not greater
+// than maximum.
+func networkingSegmentRangeV2ValidateRange(minimum, maximum int) error {
+	if minimum > maximum {
+		return fmt.Errorf("minimum %d is greater than maximum %d", minimum, maximum)
+	}
+
+	return nil
+}
+
+// flattenNetworkingSegmentRangeV2Used converts the used segmentation IDs of a
+// segment range into a list sorted by the segmentation ID.
+func flattenNetworkingSegmentRangeV2Used(used map[string]string) []map[string]any {
+	ids := make([]int, 0, len(used))
+	projects := make(map[int]string, len(used))
+
+	for k, v := range used {
+		id, err := strconv.Atoi(k)
+		if err != nil {
+			continue
+		}
+
+		ids = append(ids, id)
+		projects[id] = v
+	}
+
+	slices.Sort(ids)
+
+	res := make([]map[string]any, len(ids))
+	for i, id := range ids {
+		res[i] = map[string]any{
+			"segmentation_id": id,
+			"project_id":      projects[id],
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,70 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitNetworkingSegmentRangeV2Get(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/network_segment_ranges/ce0cc7a9-4a7f-4a5f-8a0c-7e1bcbd3b1f1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "network_segment_range": {
+    "id": "ce0cc7a9-4a7f-4a5f-8a0c-7e1bcbd3b1f1",
+    "name": "range_1",
+    "default": false,
+    "shared": false,
+    "project_id": "7011dfe3ba6c4d3cbe8d7d0bdbc1f4b6",
+    "network_type": "vlan",
+    "physical_network": "physnet1",
+    "minimum": 100,
+    "maximum": 103,
+    "available": [100, 103],
+    "used": {"102": "7011dfe3ba6c4d3cbe8d7d0bdbc1f4b6", "101": "7011dfe3ba6c4d3cbe8d7d0bdbc1f4b6"},
+    "revision_number": 2
+  }
+}`)
+	})
+
+	r, err := networkingSegmentRangeV2Get(t.Context(), thclient.ServiceClient(fakeServer), "ce0cc7a9-4a7f-4a5f-8a0c-7e1bcbd3b1f1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "vlan", r.NetworkType)
+	assert.Equal(t, "physnet1", r.PhysicalNetwork)
+	assert.Equal(t, 100, r.Minimum)
+	assert.Equal(t, 103, r.Maximum)
+	assert.Equal(t, []int{100, 103}, r.Available)
+
+	expected := []map[string]any{
+		{"segmentation_id": 101, "project_id": "7011dfe3ba6c4d3cbe8d7d0bdbc1f4b6"},
+		{"segmentation_id": 102, "project_id": "7011dfe3ba6c4d3cbe8d7d0bdbc1f4b6"},
+	}
+	assert.Equal(t, expected, flattenNetworkingSegmentRangeV2Used(r.Used))
+}
+
+func TestUnitNetworkingSegmentRangeV2ValidateProject(t *testing.T) {
+	require.NoError(t, networkingSegmentRangeV2ValidateProject(true, false))
+	require.NoError(t, networkingSegmentRangeV2ValidateProject(false, true))
+	require.EqualError(t, networkingSegmentRangeV2ValidateProject(true, true),
+		"project_id can't be set for a shared segment range")
+	require.EqualError(t, networkingSegmentRangeV2ValidateProject(false, false),
+		"project_id is required for a segment range, which is not shared")
+}
+
+func TestUnitNetworkingSegmentRangeV2ValidateRange(t *testing.T) {
+	require.NoError(t, networkingSegmentRangeV2ValidateRange(100, 200))
+	require.NoError(t, networkingSegmentRangeV2ValidateRange(100, 100))
+	require.EqualError(t, networkingSegmentRangeV2ValidateRange(200, 100),
+		"minimum 200 is greater than maximum 100")
+}
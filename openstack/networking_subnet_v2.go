@@ -1,14 +1,18 @@
 package openstack
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // networkingSubnetV2StateRefreshFunc returns a standard retry.StateRefreshFunc to wait for subnet status.
@@ -158,3 +162,158 @@ func networkingSubnetV2DNSNameserverAreUnique(raw []any) error {
 
 	return nil
 }
+
+// resourceNetworkingSubnetV2CustomizeDiff validates the CIDR related
+// arguments at plan time, so that invalid subnets are not rejected by Neutron
+// in the middle of an apply. Existing subnets are only validated, when one of
+// these arguments changes.
+func resourceNetworkingSubnetV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" && !d.HasChanges("cidr", "gateway_ip", "allocation_pool", "subnetpool_id") {
+		return nil
+	}
+
+	var cidr *net.IPNet
+
+	if d.NewValueKnown("cidr") && d.Get("cidr").(string) != "" {
+		var err error
+
+		cidr, err = networkingSubnetV2ParseCIDR(d.Get("cidr").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	if cidr != nil {
+		var pools []subnets.AllocationPool
+
+		if d.NewValueKnown("allocation_pool") {
+			pools = expandNetworkingSubnetV2AllocationPools(d.Get("allocation_pool").(*schema.Set).List())
+			if err := networkingSubnetV2ValidateAllocationPools(cidr, pools); err != nil {
+				return err
+			}
+		}
+
+		if d.NewValueKnown("gateway_ip") && !d.Get("no_gateway").(bool) {
+			if err := networkingSubnetV2ValidateGatewayIP(cidr, d.Get("gateway_ip").(string), pools); err != nil {
+				return err
+			}
+		}
+	}
+
+	subnetPoolID := d.Get("subnetpool_id").(string)
+	if !d.NewValueKnown("subnetpool_id") || subnetPoolID == "" || !d.HasChanges("cidr", "prefix_length", "subnetpool_id") {
+		return nil
+	}
+
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegionFromResourceDiff(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+	}
+
+	pool, err := subnetpools.Get(ctx, networkingClient, subnetPoolID).Extract()
+	if err != nil {
+		// The subnet pool may be created in the same apply, Neutron will
+		// validate the subnet then.
+		log.Printf("[DEBUG] Unable to retrieve subnetpool %s to validate openstack_networking_subnet_v2: %s", subnetPoolID, err)
+
+		return nil
+	}
+
+	if cidr != nil {
+		return networkingSubnetV2ValidateSubnetPool(cidr, pool)
+	}
+
+	if d.NewValueKnown("prefix_length") {
+		if v := d.Get("prefix_length").(int); v != 0 && (v < pool.MinPrefixLen || v > pool.MaxPrefixLen) {
+			return fmt.Errorf("prefix_length %d is not between the minimum (%d) and maximum (%d) prefix length of subnetpool %s",
+				v, pool.MinPrefixLen, pool.MaxPrefixLen, pool.ID)
+		}
+	}
+
+	return nil
+}
+
+func networkingSubnetV2ParseCIDR(cidr string) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid CIDR %s: %w", cidr, err)
+	}
+
+	if ipNet.String() != cidr {
+		return nil, fmt.Errorf("cidr %s doesn't match subnet address %s for openstack_networking_subnet_v2", cidr, ipNet.String())
+	}
+
+	return ipNet, nil
+}
+
+func networkingSubnetV2ValidateAllocationPools(cidr *net.IPNet, pools []subnets.AllocationPool) error {
+	for _, pool := range pools {
+		start := net.ParseIP(pool.Start)
+		if start == nil || !cidr.Contains(start) {
+			return fmt.Errorf("allocation_pool start %s is not within cidr %s", pool.Start, cidr)
+		}
+
+		end := net.ParseIP(pool.End)
+		if end == nil || !cidr.Contains(end) {
+			return fmt.Errorf("allocation_pool end %s is not within cidr %s", pool.End, cidr)
+		}
+
+		if bytes.Compare(start.To16(), end.To16()) > 0 {
+			return fmt.Errorf("allocation_pool start %s is greater than its end %s", pool.Start, pool.End)
+		}
+	}
+
+	return nil
+}
+
+func networkingSubnetV2ValidateGatewayIP(cidr *net.IPNet, gatewayIP string, pools []subnets.AllocationPool) error {
+	if gatewayIP == "" {
+		return nil
+	}
+
+	gw := net.ParseIP(gatewayIP)
+	if gw == nil {
+		return fmt.Errorf("gateway_ip %s is not a valid IP address", gatewayIP)
+	}
+
+	// Neutron allows a gateway outside of the subnet, e.g. for on-link
+	// routes, so only a conflict with an allocation pool is an error.
+	for _, pool := range pools {
+		start, end := net.ParseIP(pool.Start).To16(), net.ParseIP(pool.End).To16()
+		if bytes.Compare(gw.To16(), start) >= 0 && bytes.Compare(gw.To16(), end) <= 0 {
+			return fmt.Errorf("gateway_ip %s conflicts with allocation_pool %s-%s", gatewayIP, pool.Start, pool.End)
+		}
+	}
+
+	return nil
+}
+
+func networkingSubnetV2ValidateSubnetPool(cidr *net.IPNet, pool *subnetpools.SubnetPool) error {
+	ones, _ := cidr.Mask.Size()
+	if ones < pool.MinPrefixLen || ones > pool.MaxPrefixLen {
+		return fmt.Errorf("the prefix length of cidr %s is not between the minimum (%d) and maximum (%d) prefix length of subnetpool %s",
+			cidr, pool.MinPrefixLen, pool.MaxPrefixLen, pool.ID)
+	}
+
+	for _, prefix := range pool.Prefixes {
+		_, p, err := net.ParseCIDR(prefix)
+		if err != nil {
+			continue
+		}
+
+		prefixOnes, _ := p.Mask.Size()
+		if p.Contains(cidr.IP) && prefixOnes <= ones {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cidr %s is not within the prefixes %v of subnetpool %s", cidr, pool.Prefixes, pool.ID)
+}
+
+// networkingV2CIDRsOverlap returns true, when one of the networks contains
+// the other one.
+func networkingV2CIDRsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
@@ -2,11 +2,14 @@ package openstack
 
 import (
 	"errors"
+	"net"
 	"testing"
 
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnitNetworkingSubnetV2AllocationPools(t *testing.T) {
@@ -194,3 +197,67 @@ func TestUnitNetworkingSubnetV2DNSNameserverAreUnique(t *testing.T) {
 		assert.Equal(t, test.err, networkingSubnetV2DNSNameserverAreUnique(test.input))
 	}
 }
+
+func TestUnitNetworkingSubnetV2ParseCIDR(t *testing.T) {
+	cidr, err := networkingSubnetV2ParseCIDR("192.168.199.0/24")
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.199.0/24", cidr.String())
+
+	_, err = networkingSubnetV2ParseCIDR("192.168.199.1/24")
+	require.EqualError(t, err, "cidr 192.168.199.1/24 doesn't match subnet address 192.168.199.0/24 for openstack_networking_subnet_v2")
+
+	_, err = networkingSubnetV2ParseCIDR("192.168.199.0")
+	require.Error(t, err)
+}
+
+func TestUnitNetworkingSubnetV2ValidateAllocationPools(t *testing.T) {
+	_, cidr, _ := net.ParseCIDR("192.168.199.0/24")
+
+	err := networkingSubnetV2ValidateAllocationPools(cidr, []subnets.AllocationPool{
+		{Start: "192.168.199.10", End: "192.168.199.100"},
+	})
+	require.NoError(t, err)
+
+	err = networkingSubnetV2ValidateAllocationPools(cidr, []subnets.AllocationPool{
+		{Start: "192.168.199.10", End: "192.168.200.100"},
+	})
+	require.EqualError(t, err, "allocation_pool end 192.168.200.100 is not within cidr 192.168.199.0/24")
+
+	err = networkingSubnetV2ValidateAllocationPools(cidr, []subnets.AllocationPool{
+		{Start: "192.168.199.100", End: "192.168.199.10"},
+	})
+	require.EqualError(t, err, "allocation_pool start 192.168.199.100 is greater than its end 192.168.199.10")
+}
+
+func TestUnitNetworkingSubnetV2ValidateGatewayIP(t *testing.T) {
+	_, cidr, _ := net.ParseCIDR("2001:db8::/64")
+	pools := []subnets.AllocationPool{
+		{Start: "2001:db8::10", End: "2001:db8::ff"},
+	}
+
+	require.NoError(t, networkingSubnetV2ValidateGatewayIP(cidr, "", pools))
+	require.NoError(t, networkingSubnetV2ValidateGatewayIP(cidr, "2001:db8::1", pools))
+	require.NoError(t, networkingSubnetV2ValidateGatewayIP(cidr, "2001:db9::1", pools))
+	require.EqualError(t, networkingSubnetV2ValidateGatewayIP(cidr, "2001:db8::20", pools),
+		"gateway_ip 2001:db8::20 conflicts with allocation_pool 2001:db8::10-2001:db8::ff")
+}
+
+func TestUnitNetworkingSubnetV2ValidateSubnetPool(t *testing.T) {
+	pool := &subnetpools.SubnetPool{
+		ID:           "pool_1",
+		Prefixes:     []string{"10.10.0.0/16", "10.20.0.0/16"},
+		MinPrefixLen: 20,
+		MaxPrefixLen: 28,
+	}
+
+	_, cidr, _ := net.ParseCIDR("10.20.16.0/24")
+	require.NoError(t, networkingSubnetV2ValidateSubnetPool(cidr, pool))
+
+	_, cidr, _ = net.ParseCIDR("10.30.16.0/24")
+	require.EqualError(t, networkingSubnetV2ValidateSubnetPool(cidr, pool),
+		"cidr 10.30.16.0/24 is not within the prefixes [10.10.0.0/16 10.20.0.0/16] of subnetpool pool_1")
+
+	_, cidr, _ = net.ParseCIDR("10.20.0.0/16")
+	require.EqualError(t, networkingSubnetV2ValidateSubnetPool(cidr, pool),
+		"the prefix length of cidr 10.20.0.0/16 is not between the minimum (20) and maximum (28) prefix length of subnetpool pool_1")
+}
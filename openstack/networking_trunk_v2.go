@@ -72,3 +72,13 @@ func expandNetworkingTrunkV2SubportsRemove(subports *schema.Set) []trunks.Remove
 
 	return subportsToRemove
 }
+
+func networkingTrunkV2FindSubport(subports []trunks.Subport, portID string) (trunks.Subport, bool) {
+	for _, subport := range subports {
+		if subport.PortID == portID {
+			return subport, true
+		}
+	}
+
+	return trunks.Subport{}, false
+}
@@ -335,6 +335,8 @@ func Provider() *schema.Provider {
 			"openstack_blockstorage_snapshot_v3":                 dataSourceBlockStorageSnapshotV3(),
 			"openstack_blockstorage_volume_v3":                   dataSourceBlockStorageVolumeV3(),
 			"openstack_blockstorage_quotaset_v3":                 dataSourceBlockStorageQuotasetV3(),
+			"openstack_blockstorage_pools_v3":                    dataSourceBlockStoragePoolsV3(),
+			"openstack_blockstorage_messages_v3":                 dataSourceBlockStorageMessagesV3(),
 			"openstack_compute_aggregate_v2":                     dataSourceComputeAggregateV2(),
 			"openstack_compute_availability_zones_v2":            dataSourceComputeAvailabilityZonesV2(),
 			"openstack_compute_instance_v2":                      dataSourceComputeInstanceV2(),
@@ -349,6 +351,10 @@ func Provider() *schema.Provider {
 			"openstack_containerinfra_cluster_v1":                dataSourceContainerInfraCluster(),
 			"openstack_dns_zone_v2":                              dataSourceDNSZoneV2(),
 			"openstack_dns_zone_share_v2":                        dataSourceDNSZoneShareV2(),
+			"openstack_dns_tsigkey_v2":                           dataSourceDNSTSIGKeyV2(),
+			"openstack_dns_tld_v2":                               dataSourceDNSTLDV2(),
+			"openstack_dns_blacklist_v2":                         dataSourceDNSBlacklistV2(),
+			"openstack_dns_zone_export_v2":                       dataSourceDNSZoneExportV2(),
 			"openstack_fw_group_v2":                              dataSourceFWGroupV2(),
 			"openstack_fw_policy_v2":                             dataSourceFWPolicyV2(),
 			"openstack_fw_rule_v2":                               dataSourceFWRuleV2(),
@@ -360,9 +366,11 @@ func Provider() *schema.Provider {
 			"openstack_identity_endpoint_v3":                     dataSourceIdentityEndpointV3(),
 			"openstack_identity_service_v3":                      dataSourceIdentityServiceV3(),
 			"openstack_identity_group_v3":                        dataSourceIdentityGroupV3(),
+			"openstack_identity_domain_v3":                       dataSourceIdentityDomainV3(),
 			"openstack_images_image_v2":                          dataSourceImagesImageV2(),
 			"openstack_images_image_ids_v2":                      dataSourceImagesImageIDsV2(),
 			"openstack_networking_addressscope_v2":               dataSourceNetworkingAddressScopeV2(),
+			"openstack_networking_loggable_resources_v2":         dataSourceNetworkingLoggableResourcesV2(),
 			"openstack_networking_agent_v2":                      dataSourceNetworkingAgentV2(),
 			"openstack_networking_bgp_peer_v2":                   dataSourceNetworkingBGPPeerV2(),
 			"openstack_networking_bgp_speaker_v2":                dataSourceNetworkingBGPSpeakerV2(),
@@ -379,9 +387,13 @@ func Provider() *schema.Provider {
 			"openstack_networking_floatingip_v2":                 dataSourceNetworkingFloatingIPV2(),
 			"openstack_networking_router_v2":                     dataSourceNetworkingRouterV2(),
 			"openstack_networking_port_v2":                       dataSourceNetworkingPortV2(),
+			"openstack_networking_local_ip_v2":                   dataSourceNetworkingLocalIPV2(),
+			"openstack_networking_local_ip_association_v2":       dataSourceNetworkingLocalIPAssociationV2(),
+			"openstack_networking_ndp_proxy_v2":                  dataSourceNetworkingNDPProxyV2(),
 			"openstack_networking_port_ids_v2":                   dataSourceNetworkingPortIDsV2(),
 			"openstack_networking_trunk_v2":                      dataSourceNetworkingTrunkV2(),
 			"openstack_networking_segment_v2":                    dataSourceNetworkingSegmentV2(),
+			"openstack_networking_segment_range_v2":              dataSourceNetworkingSegmentRangeV2(),
 			"openstack_sharedfilesystem_availability_zones_v2":   dataSourceSharedFilesystemAvailabilityZonesV2(),
 			"openstack_sharedfilesystem_sharenetwork_v2":         dataSourceSharedFilesystemShareNetworkV2(),
 			"openstack_sharedfilesystem_share_v2":                dataSourceSharedFilesystemShareV2(),
@@ -389,10 +401,16 @@ func Provider() *schema.Provider {
 			"openstack_keymanager_secret_v1":                     dataSourceKeyManagerSecretV1(),
 			"openstack_keymanager_container_v1":                  dataSourceKeyManagerContainerV1(),
 			"openstack_loadbalancer_flavor_v2":                   dataSourceLoadBalancerFlavorV2(),
+			"openstack_lb_amphorae_v2":                           dataSourceLBAmphoraeV2(),
+			"openstack_lb_availability_zone_v2":                  dataSourceLBAvailabilityZoneV2(),
+			"openstack_lb_availability_zone_profile_v2":          dataSourceLBAvailabilityZoneProfileV2(),
 			"openstack_lb_flavor_v2":                             dataSourceLBFlavorV2(),
 			"openstack_lb_flavorprofile_v2":                      dataSourceLBFlavorProfileV2(),
 			"openstack_lb_loadbalancer_v2":                       dataSourceLBLoadbalancerV2(),
+			"openstack_lb_loadbalancer_status_v2":                dataSourceLBLoadbalancerStatusV2(),
+			"openstack_lb_loadbalancer_stats_v2":                 dataSourceLBLoadbalancerStatsV2(),
 			"openstack_lb_listener_v2":                           dataSourceLBListenerV2(),
+			"openstack_lb_listener_stats_v2":                     dataSourceLBListenerStatsV2(),
 			"openstack_lb_member_v2":                             dataSourceLBMemberV2(),
 			"openstack_lb_monitor_v2":                            dataSourceLBMonitorV2(),
 			"openstack_lb_pool_v2":                               dataSourceLBPoolV2(),
@@ -406,6 +424,8 @@ func Provider() *schema.Provider {
 			"openstack_blockstorage_quotaset_v3":                 resourceBlockStorageQuotasetV3(),
 			"openstack_blockstorage_volume_v3":                   resourceBlockStorageVolumeV3(),
 			"openstack_blockstorage_volume_attach_v3":            resourceBlockStorageVolumeAttachV3(),
+			"openstack_blockstorage_volume_migrate_v3":           resourceBlockStorageVolumeMigrateV3(),
+			"openstack_blockstorage_attachment_v3":               resourceBlockStorageAttachmentV3(),
 			"openstack_blockstorage_volume_type_access_v3":       resourceBlockstorageVolumeTypeAccessV3(),
 			"openstack_blockstorage_volume_type_v3":              resourceBlockStorageVolumeTypeV3(),
 			"openstack_compute_aggregate_v2":                     resourceComputeAggregateV2(),
@@ -430,6 +450,12 @@ func Provider() *schema.Provider {
 			"openstack_dns_transfer_request_v2":                  resourceDNSTransferRequestV2(),
 			"openstack_dns_transfer_accept_v2":                   resourceDNSTransferAcceptV2(),
 			"openstack_dns_quota_v2":                             resourceDNSQuotaV2(),
+			"openstack_dns_tsigkey_v2":                           resourceDNSTSIGKeyV2(),
+			"openstack_dns_tld_v2":                               resourceDNSTLDV2(),
+			"openstack_dns_blacklist_v2":                         resourceDNSBlacklistV2(),
+			"openstack_dns_floatingip_ptr_v2":                    resourceDNSFloatingIPPTRV2(),
+			"openstack_dns_zone_recordsets_v2":                   resourceDNSZoneRecordSetsV2(),
+			"openstack_dns_zone_import_v2":                       resourceDNSZoneImportV2(),
 			"openstack_fw_group_v2":                              resourceFWGroupV2(),
 			"openstack_fw_policy_v2":                             resourceFWPolicyV2(),
 			"openstack_fw_rule_v2":                               resourceFWRuleV2(),
@@ -442,6 +468,7 @@ func Provider() *schema.Provider {
 			"openstack_identity_user_v3":                         resourceIdentityUserV3(),
 			"openstack_identity_user_membership_v3":              resourceIdentityUserMembershipV3(),
 			"openstack_identity_group_v3":                        resourceIdentityGroupV3(),
+			"openstack_identity_domain_v3":                       resourceIdentityDomainV3(),
 			"openstack_identity_application_credential_v3":       resourceIdentityApplicationCredentialV3(),
 			"openstack_identity_ec2_credential_v3":               resourceIdentityEc2CredentialV3(),
 			"openstack_identity_registered_limit_v3":             resourceIdentityRegisteredLimitV3(),
@@ -449,9 +476,12 @@ func Provider() *schema.Provider {
 			"openstack_images_image_v2":                          resourceImagesImageV2(),
 			"openstack_images_image_access_v2":                   resourceImagesImageAccessV2(),
 			"openstack_images_image_access_accept_v2":            resourceImagesImageAccessAcceptV2(),
+			"openstack_lb_availability_zone_v2":                  resourceLoadBalancerAvailabilityZoneV2(),
+			"openstack_lb_availability_zone_profile_v2":          resourceLoadBalancerAvailabilityZoneProfileV2(),
 			"openstack_lb_flavor_v2":                             resourceLoadBalancerFlavorV2(),
 			"openstack_lb_flavorprofile_v2":                      resourceLoadBalancerFlavorProfileV2(),
 			"openstack_lb_loadbalancer_v2":                       resourceLoadBalancerV2(),
+			"openstack_lb_loadbalancer_failover_v2":              resourceLoadBalancerFailoverV2(),
 			"openstack_lb_listener_v2":                           resourceListenerV2(),
 			"openstack_lb_pool_v2":                               resourcePoolV2(),
 			"openstack_lb_member_v2":                             resourceMemberV2(),
@@ -479,14 +509,24 @@ func Provider() *schema.Provider {
 			"openstack_networking_router_routes_v2":              resourceNetworkingRouterRoutesV2(),
 			"openstack_networking_secgroup_v2":                   resourceNetworkingSecGroupV2(),
 			"openstack_networking_secgroup_rule_v2":              resourceNetworkingSecGroupRuleV2(),
+			"openstack_networking_secgroup_rules_v2":             resourceNetworkingSecGroupRulesV2(),
+			"openstack_networking_default_secgroup_rule_v2":      resourceNetworkingDefaultSecGroupRuleV2(),
 			"openstack_networking_address_group_v2":              resourceNetworkingAddressGroupV2(),
 			"openstack_networking_subnet_v2":                     resourceNetworkingSubnetV2(),
 			"openstack_networking_subnet_route_v2":               resourceNetworkingSubnetRouteV2(),
 			"openstack_networking_subnetpool_v2":                 resourceNetworkingSubnetPoolV2(),
 			"openstack_networking_addressscope_v2":               resourceNetworkingAddressScopeV2(),
+			"openstack_networking_log_v2":                        resourceNetworkingLogV2(),
+			"openstack_networking_metering_label_v2":             resourceNetworkingMeteringLabelV2(),
+			"openstack_networking_metering_label_rule_v2":        resourceNetworkingMeteringLabelRuleV2(),
 			"openstack_networking_trunk_v2":                      resourceNetworkingTrunkV2(),
+			"openstack_networking_trunk_subport_v2":              resourceNetworkingTrunkSubportV2(),
 			"openstack_networking_portforwarding_v2":             resourceNetworkingPortForwardingV2(),
+			"openstack_networking_local_ip_v2":                   resourceNetworkingLocalIPV2(),
+			"openstack_networking_local_ip_association_v2":       resourceNetworkingLocalIPAssociationV2(),
+			"openstack_networking_ndp_proxy_v2":                  resourceNetworkingNDPProxyV2(),
 			"openstack_networking_segment_v2":                    resourceNetworkingSegmentV2(),
+			"openstack_networking_segment_range_v2":              resourceNetworkingSegmentRangeV2(),
 			"openstack_objectstorage_account_v1":                 resourceObjectStorageAccountV1(),
 			"openstack_objectstorage_container_v1":               resourceObjectStorageContainerV1(),
 			"openstack_objectstorage_object_v1":                  resourceObjectStorageObjectV1(),
@@ -52,6 +52,12 @@ var (
 	osMagnumHTTPSProxy           = os.Getenv("OS_MAGNUM_HTTPS_PROXY")
 	osMagnumNoProxy              = os.Getenv("OS_MAGNUM_NO_PROXY")
 	osMagnumLabels               = os.Getenv("OS_MAGNUM_LABELS")
+	osVolumeMigrateHost          = os.Getenv("OS_VOLUME_MIGRATE_HOST")
+	osNetworkingLogEnvironment   = os.Getenv("OS_NETWORKING_LOG_ENVIRONMENT")
+	osRouterMultihomingEnv       = os.Getenv("OS_ROUTER_MULTIHOMING_ENVIRONMENT")
+	osSegmentRangeEnvironment    = os.Getenv("OS_SEGMENT_RANGE_ENVIRONMENT")
+	osLocalIPEnvironment         = os.Getenv("OS_LOCAL_IP_ENVIRONMENT")
+	osNDPProxyEnvironment        = os.Getenv("OS_NDP_PROXY_ENVIRONMENT")
 )
 
 var (
@@ -247,6 +253,54 @@ func testAccPreCheckWorkflow(t *testing.T) {
 	}
 }
 
+func testAccPreCheckVolumeMigrate(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osVolumeMigrateHost == "" {
+		t.Skip("OS_VOLUME_MIGRATE_HOST required to support blockstorage volume migration tests")
+	}
+}
+
+func testAccPreCheckNetworkingLog(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osNetworkingLogEnvironment == "" {
+		t.Skip("This environment does not support 'logging' extension tests")
+	}
+}
+
+func testAccPreCheckRouterMultihoming(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osRouterMultihomingEnv == "" {
+		t.Skip("This environment does not support 'external-gateway-multihoming' extension tests")
+	}
+}
+
+func testAccPreCheckSegmentRange(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osSegmentRangeEnvironment == "" {
+		t.Skip("This environment does not support 'network-segment-range' extension tests")
+	}
+}
+
+func testAccPreCheckLocalIP(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osLocalIPEnvironment == "" {
+		t.Skip("This environment does not support 'local_ip' extension tests")
+	}
+}
+
+func testAccPreCheckNDPProxy(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osNDPProxyEnvironment == "" {
+		t.Skip("This environment does not support 'l3-ndp-proxy' extension tests")
+	}
+}
+
 func testAccPreCheckAdminOnly(t *testing.T) {
 	v := os.Getenv("OS_USERNAME")
 	if v != "admin" {
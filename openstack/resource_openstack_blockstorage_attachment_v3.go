@@ -0,0 +1,310 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/attachments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBlockStorageAttachmentV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageAttachmentV3Create,
+		ReadContext:   resourceBlockStorageAttachmentV3Read,
+		UpdateContext: resourceBlockStorageAttachmentV3Update,
+		DeleteContext: resourceBlockStorageAttachmentV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBlockStorageAttachmentV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"attach_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ro", "rw",
+				}, false),
+			},
+
+			"connector": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"ip": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"initiator": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"multipath": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"os_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"platform": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"mountpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"nqn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"wwpns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"wwnns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"value_specs": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"complete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			// Attachment information
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"attached_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"driver_volume_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"data": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageAttachmentV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	client.Microversion = blockstorageV3AttachmentCompleteMicroversion
+
+	createOpts := attachments.CreateOpts{
+		VolumeUUID:   d.Get("volume_id").(string),
+		InstanceUUID: d.Get("instance_id").(string),
+		Connector:    expandBlockStorageAttachmentV3Connector(d.Get("connector").([]any)),
+		Mode:         d.Get("attach_mode").(string),
+	}
+
+	if createOpts.Mode != "" {
+		bumpClientMicroversion(client, blockstorageV3AttachmentModeMicroversion)
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_attachment_v3 create options: %#v", createOpts)
+
+	attachment, err := attachments.Create(ctx, client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_blockstorage_attachment_v3: %s", err)
+	}
+
+	// Store the ID now, so that the attachment is deleted on the next apply
+	// when the remaining steps fail or the apply is interrupted.
+	d.SetId(attachment.ID)
+
+	if err := blockStorageAttachmentV3Complete(ctx, d, client, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_blockstorage_attachment_v3 %s", attachment.ID)
+
+	return resourceBlockStorageAttachmentV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageAttachmentV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	client.Microversion = blockstorageV3AttachmentCompleteMicroversion
+
+	attachment, err := attachments.Get(ctx, client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_attachment_v3"))
+	}
+
+	// Only uncomment this when debugging since the attachment contains sensitive information.
+	// log.Printf("[DEBUG] Retrieved openstack_blockstorage_attachment_v3 %s: %#v", d.Id(), attachment)
+
+	driverVolumeType, data := flattenBlockStorageAttachmentV3ConnectionInfo(attachment.ConnectionInfo)
+
+	d.Set("volume_id", attachment.VolumeID)
+	d.Set("instance_id", attachment.Instance)
+	d.Set("attach_mode", attachment.AttachMode)
+	d.Set("status", attachment.Status)
+	d.Set("driver_volume_type", driverVolumeType)
+	d.Set("data", data)
+	d.Set("region", GetRegion(d, config))
+
+	if !attachment.AttachedAt.IsZero() {
+		d.Set("attached_at", attachment.AttachedAt.Format(time.RFC3339))
+	} else {
+		d.Set("attached_at", "")
+	}
+
+	return nil
+}
+
+func resourceBlockStorageAttachmentV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	client.Microversion = blockstorageV3AttachmentCompleteMicroversion
+
+	if d.HasChange("connector") {
+		connector := expandBlockStorageAttachmentV3Connector(d.Get("connector").([]any))
+		if connector == nil {
+			return diag.Errorf("Error updating openstack_blockstorage_attachment_v3 %s: the connector cannot be removed", d.Id())
+		}
+
+		updateOpts := attachments.UpdateOpts{
+			Connector: connector,
+		}
+
+		log.Printf("[DEBUG] openstack_blockstorage_attachment_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = attachments.Update(ctx, client, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_blockstorage_attachment_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("connector", "complete") {
+		if err := blockStorageAttachmentV3Complete(ctx, d, client, schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceBlockStorageAttachmentV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageAttachmentV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	client.Microversion = blockstorageV3AttachmentCompleteMicroversion
+
+	if err := attachments.Delete(ctx, client, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_blockstorage_attachment_v3"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"reserved", "attaching", "attached", "detaching"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageAttachmentV3StateRefreshFunc(ctx, client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_blockstorage_attachment_v3 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageAttachmentV3Import(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	d.Set("complete", true)
+
+	return []*schema.ResourceData{d}, nil
+}
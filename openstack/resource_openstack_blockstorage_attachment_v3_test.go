@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/attachments"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockStorageAttachmentV3_basic(t *testing.T) {
+	var attachment attachments.Attachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageAttachmentV3Destroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageAttachmentV3Reserved,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageAttachmentV3Exists(t.Context(), "openstack_blockstorage_attachment_v3.attachment_1", &attachment),
+					resource.TestCheckResourceAttr("openstack_blockstorage_attachment_v3.attachment_1", "status", "reserved"),
+				),
+			},
+			{
+				Config: testAccBlockStorageAttachmentV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageAttachmentV3Exists(t.Context(), "openstack_blockstorage_attachment_v3.attachment_1", &attachment),
+					resource.TestCheckResourceAttr("openstack_blockstorage_attachment_v3.attachment_1", "status", "attached"),
+					resource.TestCheckResourceAttr("openstack_blockstorage_attachment_v3.attachment_1", "driver_volume_type", "iscsi"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageAttachmentV3Destroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		client, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		client.Microversion = blockstorageV3AttachmentCompleteMicroversion
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_blockstorage_attachment_v3" {
+				continue
+			}
+
+			_, err := attachments.Get(ctx, client, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Attachment still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageAttachmentV3Exists(ctx context.Context, n string, attachment *attachments.Attachment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		client, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		client.Microversion = blockstorageV3AttachmentCompleteMicroversion
+
+		found, err := attachments.Get(ctx, client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Attachment not found")
+		}
+
+		*attachment = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageAttachmentV3Reserved = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_attachment_v3" "attachment_1" {
+  volume_id = openstack_blockstorage_volume_v3.volume_1.id
+}
+`
+
+const testAccBlockStorageAttachmentV3Basic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_attachment_v3" "attachment_1" {
+  volume_id = openstack_blockstorage_volume_v3.volume_1.id
+
+  connector {
+    host      = "devstack"
+    ip        = "192.168.255.10"
+    initiator = "iqn.1993-08.org.debian:01:e9861fb1859"
+    os_type   = "linux2"
+    platform  = "x86_64"
+  }
+}
+`
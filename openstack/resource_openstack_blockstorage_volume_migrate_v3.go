@@ -0,0 +1,168 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBlockStorageVolumeMigrateV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageVolumeMigrateV3Create,
+		ReadContext:   resourceBlockStorageVolumeMigrateV3Read,
+		UpdateContext: resourceBlockStorageVolumeMigrateV3Update,
+		DeleteContext: resourceBlockStorageVolumeMigrateV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"host": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"host", "cluster"},
+			},
+
+			"cluster": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"host", "cluster"},
+			},
+
+			"force_host_copy": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				DiffSuppressFunc: suppressBlockStorageVolumeMigrateV3OptionDiff,
+			},
+
+			"lock_volume": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				DiffSuppressFunc: suppressBlockStorageVolumeMigrateV3OptionDiff,
+			},
+
+			"volume_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"migration_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// suppressBlockStorageVolumeMigrateV3OptionDiff ignores changes of the
+// migration options, unless the volume is migrated again, because they only
+// apply to a migration.
+func suppressBlockStorageVolumeMigrateV3OptionDiff(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != "" && !d.HasChanges("host", "cluster")
+}
+
+func resourceBlockStorageVolumeMigrateV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	volumeID := d.Get("volume_id").(string)
+
+	if err := resourceBlockStorageVolumeMigrateV3Migrate(ctx, d, meta, schema.TimeoutCreate); err != nil {
+		return err
+	}
+
+	d.SetId(volumeID)
+
+	return resourceBlockStorageVolumeMigrateV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageVolumeMigrateV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	v, err := blockStorageV3GetVolumeMigration(ctx, blockStorageClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_volume_migrate_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_migrate_v3 %s: %#v", d.Id(), v)
+
+	d.Set("volume_id", d.Id())
+	d.Set("volume_host", v.Host)
+	d.Set("migration_status", v.MigrationStatus)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeMigrateV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.HasChanges("host", "cluster") {
+		if err := resourceBlockStorageVolumeMigrateV3Migrate(ctx, d, meta, schema.TimeoutUpdate); err != nil {
+			return err
+		}
+	}
+
+	return resourceBlockStorageVolumeMigrateV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageVolumeMigrateV3Delete(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+	// The volume stays on its current host, there is nothing to undo.
+	return nil
+}
+
+func resourceBlockStorageVolumeMigrateV3Migrate(ctx context.Context, d *schema.ResourceData, meta any, timeout string) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	migrateOpts := blockStorageV3MigrateOpts{
+		Host:          d.Get("host").(string),
+		Cluster:       d.Get("cluster").(string),
+		ForceHostCopy: d.Get("force_host_copy").(bool),
+		LockVolume:    d.Get("lock_volume").(bool),
+	}
+
+	if migrateOpts.Cluster != "" {
+		blockStorageClient.Microversion = blockstorageV3MigrateClusterMicroversion
+	}
+
+	current, err := blockStorageV3GetVolumeMigration(ctx, blockStorageClient, volumeID)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_blockstorage_volume_v3 %s: %s", volumeID, err)
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_volume_migrate_v3 %s migrate options: %#v", volumeID, migrateOpts)
+
+	if err := blockStorageV3MigrateVolume(ctx, blockStorageClient, volumeID, migrateOpts); err != nil {
+		return diag.Errorf("Error migrating openstack_blockstorage_volume_migrate_v3 %s: %s", volumeID, err)
+	}
+
+	if _, err := blockStorageVolumeV3WaitForMigration(ctx, blockStorageClient, volumeID, current.MigrationStatus, d.Timeout(timeout)); err != nil {
+		return diag.Errorf("Error waiting for openstack_blockstorage_volume_migrate_v3 %s to finish: %s", volumeID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlockStorageVolumeMigrateV3_basic(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckVolumeMigrate(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3VolumeDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageVolumeMigrateV3Basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists(t.Context(), "openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_migrate_v3.migrate_1", "volume_host", osVolumeMigrateHost),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_migrate_v3.migrate_1", "migration_status", "success"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageVolumeMigrateV3Basic() string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_migrate_v3" "migrate_1" {
+  volume_id = openstack_blockstorage_volume_v3.volume_1.id
+  host      = "%s"
+}
+`, osVolumeMigrateHost)
+}
@@ -361,35 +361,38 @@ func resourceBlockStorageVolumeV3Update(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("volume_type") {
-		_, err = volumes.Get(ctx, blockStorageClient, d.Id()).Extract()
+		current, err := blockStorageV3GetVolumeMigration(ctx, blockStorageClient, d.Id())
 		if err != nil {
 			return diag.Errorf("Error changing volume type openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
 		}
 
+		oldType, newType := d.GetChange("volume_type")
 		retypeOptions := &volumes.ChangeTypeOpts{
-			NewType:         d.Get("volume_type").(string),
+			NewType:         newType.(string),
 			MigrationPolicy: volumes.MigrationPolicy(d.Get("volume_retype_policy").(string)),
 		}
 
-		err := volumes.ChangeType(ctx, blockStorageClient, d.Id(), retypeOptions).ExtractErr()
+		err = volumes.ChangeType(ctx, blockStorageClient, d.Id(), retypeOptions).ExtractErr()
 		if err != nil {
 			return diag.Errorf("Error changing volume %s type: %s", d.Id(), err)
 		}
 
-		stateConf := &retry.StateChangeConf{
-			Pending:    []string{"retyping"},
-			Target:     []string{"available", "in-use"},
-			Refresh:    blockStorageVolumeV3StateRefreshFunc(ctx, blockStorageClient, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      0,
-			MinTimeout: 3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForStateContext(ctx)
+		// A retype may involve a migration to another backend, so wait for
+		// both the retype and the migration to finish.
+		v, err := blockStorageVolumeV3WaitForMigration(ctx, blockStorageClient, d.Id(), current.MigrationStatus, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.Errorf(
 				"Error waiting for openstack_blockstorage_volume_v3 %s to become ready: %s", d.Id(), err)
 		}
+
+		// Cinder doesn't report a failed retype in the volume status, the
+		// volume simply keeps its old type.
+		if v.VolumeType != newType.(string) && v.VolumeType == oldType.(string) {
+			err := blockStorageV3ErrorWithMessage(ctx, blockStorageClient, d.Id(),
+				fmt.Errorf("volume type is still %q", v.VolumeType))
+
+			return diag.Errorf("Error changing volume %s type: %s", d.Id(), err)
+		}
 	}
 
 	_, err = volumes.Update(ctx, blockStorageClient, d.Id(), updateOpts).Extract()
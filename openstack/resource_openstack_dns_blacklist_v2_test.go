@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSV2Blacklist_basic(t *testing.T) {
+	pattern := fmt.Sprintf(`^([A-Za-z0-9_\\-]+\\.)*acpttest%s\\.com\\.$`, strings.ToLower(acctest.RandString(5)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2BlacklistDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2BlacklistBasic(pattern, "a blacklist"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openstack_dns_blacklist_v2.blacklist_1", "pattern"),
+					resource.TestCheckResourceAttr("openstack_dns_blacklist_v2.blacklist_1", "description", "a blacklist"),
+				),
+			},
+			{
+				Config: testAccDNSV2BlacklistBasic(pattern, "an updated blacklist"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_dns_blacklist_v2.blacklist_1", "description", "an updated blacklist"),
+				),
+			},
+			{
+				ResourceName:      "openstack_dns_blacklist_v2.blacklist_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2BlacklistDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_dns_blacklist_v2" {
+				continue
+			}
+
+			_, err := dnsBlacklistV2Get(ctx, dnsClient, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("Blacklist still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDNSV2BlacklistBasic(pattern, description string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_blacklist_v2" "blacklist_1" {
+			pattern = "%s"
+			description = "%s"
+		}
+	`, pattern, description)
+}
@@ -0,0 +1,193 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDNSFloatingIPPTRV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSFloatingIPPTRV2Create,
+		ReadContext:   resourceDNSFloatingIPPTRV2Read,
+		UpdateContext: resourceDNSFloatingIPPTRV2Update,
+		DeleteContext: resourceDNSFloatingIPPTRV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"floatingip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ptrdname": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`\.$`), "fully-qualified (unambiguous) DNS domain names must have a dot at the end"),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"disable_status_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSFloatingIPPTRV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+
+	dnsClient, err := config.DNSV2Client(ctx, region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	id := dnsFloatingIPPTRV2ID(region, d.Get("floatingip_id").(string))
+	opts := dnsFloatingIPPTRV2SetOpts{
+		PTRDName:    d.Get("ptrdname").(string),
+		Description: d.Get("description").(string),
+		TTL:         d.Get("ttl").(int),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_floatingip_ptr_v2 %s create options: %#v", id, opts)
+
+	_, err = dnsFloatingIPPTRV2Set(ctx, dnsClient, id, opts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_floatingip_ptr_v2 %s: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if !d.Get("disable_status_check").(bool) {
+		err = dnsFloatingIPPTRV2WaitForActive(ctx, dnsClient, id, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Created openstack_dns_floatingip_ptr_v2 %s", id)
+
+	return resourceDNSFloatingIPPTRV2Read(ctx, d, meta)
+}
+
+func resourceDNSFloatingIPPTRV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	region, floatingIPID, err := parseDNSFloatingIPPTRV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The region is unset right after an import, the ID contains it.
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	dnsClient, err := config.DNSV2Client(ctx, region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	ptr, err := dnsFloatingIPPTRV2Get(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_floatingip_ptr_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_floatingip_ptr_v2 %s: %#v", d.Id(), ptr)
+
+	if ptr.PTRDName == "" {
+		log.Printf("[DEBUG] openstack_dns_floatingip_ptr_v2 %s is unset, removing from state", d.Id())
+		d.SetId("")
+
+		return nil
+	}
+
+	d.Set("region", region)
+	d.Set("floatingip_id", floatingIPID)
+	d.Set("ptrdname", ptr.PTRDName)
+	d.Set("description", ptr.Description)
+	d.Set("ttl", ptr.TTL)
+	d.Set("address", ptr.Address)
+
+	return nil
+}
+
+func resourceDNSFloatingIPPTRV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if d.HasChanges("ptrdname", "description", "ttl") {
+		opts := dnsFloatingIPPTRV2SetOpts{
+			PTRDName:    d.Get("ptrdname").(string),
+			Description: d.Get("description").(string),
+			TTL:         d.Get("ttl").(int),
+		}
+
+		err = dnsFloatingIPPTRV2Apply(ctx, dnsClient, d.Id(), opts, d.Get("disable_status_check").(bool), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error updating openstack_dns_floatingip_ptr_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDNSFloatingIPPTRV2Read(ctx, d, meta)
+}
+
+func resourceDNSFloatingIPPTRV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = dnsFloatingIPPTRV2Remove(ctx, dnsClient, d.Id(), d.Get("disable_status_check").(bool), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("Error deleting openstack_dns_floatingip_ptr_v2 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
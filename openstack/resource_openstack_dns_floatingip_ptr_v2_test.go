@@ -0,0 +1,91 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSV2FloatingIPPTR_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2FloatingIPPTRDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2FloatingIPPTRBasic("a PTR record", 3000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "ptrdname", "mail.example.com."),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "description", "a PTR record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "ttl", "3000"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "address",
+						"openstack_networking_floatingip_v2.fip_1", "address"),
+				),
+			},
+			{
+				Config: testAccDNSV2FloatingIPPTRBasic("an updated PTR record", 6000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "description", "an updated PTR record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "ttl", "6000"),
+				),
+			},
+			{
+				ResourceName:            "openstack_dns_floatingip_ptr_v2.ptr_1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_status_check"},
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2FloatingIPPTRDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_dns_floatingip_ptr_v2" {
+				continue
+			}
+
+			ptr, err := dnsFloatingIPPTRV2Get(ctx, dnsClient, rs.Primary.ID)
+			if err == nil && ptr.PTRDName != "" {
+				return fmt.Errorf("PTR record %s still set", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDNSV2FloatingIPPTRBasic(description string, ttl int) string {
+	return fmt.Sprintf(`
+		resource "openstack_networking_floatingip_v2" "fip_1" {
+		}
+
+		resource "openstack_dns_floatingip_ptr_v2" "ptr_1" {
+			floatingip_id = openstack_networking_floatingip_v2.fip_1.id
+			ptrdname = "mail.example.com."
+			description = "%s"
+			ttl = %d
+		}
+	`, description, ttl)
+}
@@ -0,0 +1,142 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDNSTLDV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSTLDV2Create,
+		ReadContext:   resourceDNSTLDV2Read,
+		UpdateContext: resourceDNSTLDV2Update,
+		DeleteContext: resourceDNSTLDV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceDNSTLDV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	createOpts := dnsTLDV2CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_tld_v2 create options: %#v", createOpts)
+
+	tld, err := dnsTLDV2Create(ctx, dnsClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_tld_v2: %s", err)
+	}
+
+	d.SetId(tld.ID)
+
+	log.Printf("[DEBUG] Created openstack_dns_tld_v2 %s: %#v", tld.ID, tld)
+
+	return resourceDNSTLDV2Read(ctx, d, meta)
+}
+
+func resourceDNSTLDV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	tld, err := dnsTLDV2Get(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_tld_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_tld_v2 %s: %#v", d.Id(), tld)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", tld.Name)
+	d.Set("description", tld.Description)
+
+	return nil
+}
+
+func resourceDNSTLDV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts dnsTLDV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_dns_tld_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = dnsTLDV2Update(ctx, dnsClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_dns_tld_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDNSTLDV2Read(ctx, d, meta)
+}
+
+func resourceDNSTLDV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = dnsTLDV2Delete(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_tld_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSV2TLD_basic(t *testing.T) {
+	tldName := "acpttest" + strings.ToLower(acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2TLDDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TLDBasic(tldName, "a TLD"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_dns_tld_v2.tld_1", "name", tldName),
+					resource.TestCheckResourceAttr("openstack_dns_tld_v2.tld_1", "description", "a TLD"),
+				),
+			},
+			{
+				Config: testAccDNSV2TLDBasic(tldName, "an updated TLD"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_dns_tld_v2.tld_1", "description", "an updated TLD"),
+				),
+			},
+			{
+				ResourceName:      "openstack_dns_tld_v2.tld_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2TLDDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_dns_tld_v2" {
+				continue
+			}
+
+			_, err := dnsTLDV2Get(ctx, dnsClient, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("TLD still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDNSV2TLDBasic(tldName, description string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_tld_v2" "tld_1" {
+			name = "%s"
+			description = "%s"
+		}
+	`, tldName, description)
+}
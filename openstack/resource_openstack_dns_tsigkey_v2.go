@@ -0,0 +1,194 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/tsigkeys"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dnsTSIGKeyV2Algorithms = []string{
+	"hmac-md5",
+	"hmac-sha1",
+	"hmac-sha224",
+	"hmac-sha256",
+	"hmac-sha384",
+	"hmac-sha512",
+}
+
+func resourceDNSTSIGKeyV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSTSIGKeyV2Create,
+		ReadContext:   resourceDNSTSIGKeyV2Read,
+		UpdateContext: resourceDNSTSIGKeyV2Update,
+		DeleteContext: resourceDNSTSIGKeyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dnsTSIGKeyV2Algorithms, false),
+			},
+
+			"secret": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ZONE", "POOL",
+				}, false),
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceDNSTSIGKeyV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	createOpts := tsigkeys.CreateOpts{
+		Name:       d.Get("name").(string),
+		Algorithm:  d.Get("algorithm").(string),
+		Secret:     d.Get("secret").(string),
+		Scope:      d.Get("scope").(string),
+		ResourceID: d.Get("resource_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_tsigkey_v2 create options: name %s, algorithm %s, scope %s, resource_id %s",
+		createOpts.Name, createOpts.Algorithm, createOpts.Scope, createOpts.ResourceID)
+
+	key, err := tsigkeys.Create(ctx, dnsClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_tsigkey_v2: %s", err)
+	}
+
+	d.SetId(key.ID)
+
+	log.Printf("[DEBUG] Created openstack_dns_tsigkey_v2 %s", key.ID)
+
+	return resourceDNSTSIGKeyV2Read(ctx, d, meta)
+}
+
+func resourceDNSTSIGKeyV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	key, err := tsigkeys.Get(ctx, dnsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_tsigkey_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_tsigkey_v2 %s: name %s, algorithm %s, scope %s, resource_id %s",
+		d.Id(), key.Name, key.Algorithm, key.Scope, key.ResourceID)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", key.Name)
+	d.Set("algorithm", key.Algorithm)
+	d.Set("secret", key.Secret)
+	d.Set("scope", key.Scope)
+	d.Set("resource_id", key.ResourceID)
+
+	return nil
+}
+
+func resourceDNSTSIGKeyV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts tsigkeys.UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("algorithm") {
+		hasChange = true
+		updateOpts.Algorithm = d.Get("algorithm").(string)
+	}
+
+	if d.HasChange("secret") {
+		hasChange = true
+		updateOpts.Secret = d.Get("secret").(string)
+	}
+
+	if d.HasChange("scope") {
+		hasChange = true
+		updateOpts.Scope = d.Get("scope").(string)
+	}
+
+	if d.HasChange("resource_id") {
+		hasChange = true
+		updateOpts.ResourceID = d.Get("resource_id").(string)
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating openstack_dns_tsigkey_v2 %s", d.Id())
+
+		_, err = tsigkeys.Update(ctx, dnsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_dns_tsigkey_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDNSTSIGKeyV2Read(ctx, d, meta)
+}
+
+func resourceDNSTSIGKeyV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = tsigkeys.Delete(ctx, dnsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_tsigkey_v2"))
+	}
+
+	return nil
+}
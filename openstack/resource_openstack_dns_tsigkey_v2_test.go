@@ -0,0 +1,93 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/tsigkeys"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSV2TSIGKey_basic(t *testing.T) {
+	zoneName := randomZoneName()
+	keyName := "ACPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2TSIGKeyDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TSIGKeyBasic(zoneName, keyName, "hmac-sha256"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_dns_tsigkey_v2.key_1", "name", keyName),
+					resource.TestCheckResourceAttr("openstack_dns_tsigkey_v2.key_1", "algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr("openstack_dns_tsigkey_v2.key_1", "scope", "ZONE"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_tsigkey_v2.key_1", "resource_id", "openstack_dns_zone_v2.zone_1", "id"),
+				),
+			},
+			{
+				Config: testAccDNSV2TSIGKeyBasic(zoneName, keyName, "hmac-sha512"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_dns_tsigkey_v2.key_1", "algorithm", "hmac-sha512"),
+				),
+			},
+			{
+				ResourceName:      "openstack_dns_tsigkey_v2.key_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2TSIGKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_dns_tsigkey_v2" {
+				continue
+			}
+
+			_, err := tsigkeys.Get(ctx, dnsClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return fmt.Errorf("TSIG key still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDNSV2TSIGKeyBasic(zoneName, keyName, algorithm string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email2@example.com"
+			ttl = 6000
+			type = "PRIMARY"
+		}
+
+		resource "openstack_dns_tsigkey_v2" "key_1" {
+			name = "%s"
+			algorithm = "%s"
+			secret = "SomeSecretKey"
+			scope = "ZONE"
+			resource_id = openstack_dns_zone_v2.zone_1.id
+		}
+	`, zoneName, keyName, algorithm)
+}
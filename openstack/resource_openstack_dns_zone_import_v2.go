@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-provider-openstack/terraform-provider-openstack/v3/openstack/internal/pathorcontents"
+)
+
+func resourceDNSZoneImportV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneImportV2Create,
+		ReadContext:   resourceDNSZoneImportV2Read,
+		DeleteContext: resourceDNSZoneImportV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"zone_file": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"disable_status_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSZoneImportV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	zoneFile, _, err := pathorcontents.Read(d.Get("zone_file").(string))
+	if err != nil {
+		return diag.Errorf("Error reading zone_file for openstack_dns_zone_import_v2: %s", err)
+	}
+
+	task, err := dnsZoneImportV2Create(ctx, dnsClient, zoneFile)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_zone_import_v2: %s", err)
+	}
+
+	d.SetId(task.ID)
+
+	log.Printf("[DEBUG] Created openstack_dns_zone_import_v2 %s: %#v", task.ID, task)
+
+	if d.Get("disable_status_check").(bool) {
+		return resourceDNSZoneImportV2Read(ctx, d, meta)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"COMPLETE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsZoneTaskV2RefreshFunc(ctx, dnsClient, task.ID, dnsZoneImportV2Get),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_dns_zone_import_v2 %s to complete: %s", task.ID, err)
+	}
+
+	return resourceDNSZoneImportV2Read(ctx, d, meta)
+}
+
+func resourceDNSZoneImportV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	task, err := dnsZoneImportV2Get(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_zone_import_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_zone_import_v2 %s: %#v", d.Id(), task)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("zone_id", task.ZoneID)
+	d.Set("project_id", task.ProjectID)
+	d.Set("status", task.Status)
+	d.Set("message", task.Message)
+
+	return nil
+}
+
+func resourceDNSZoneImportV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	// Only the import task is deleted, the imported zone is kept.
+	err = dnsZoneImportV2Delete(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_zone_import_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/zones"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSV2ZoneImport_basic(t *testing.T) {
+	zoneName := strings.ToLower(fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2ZoneImportDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ZoneImportBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_zone_import_v2.import_1", "status", "COMPLETE"),
+					resource.TestMatchResourceAttr(
+						"openstack_dns_zone_import_v2.import_1", "zone_id", regexp.MustCompile("^[a-f0-9-]+$")),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_zone_v2.zone_1", "name", zoneName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDNSV2ZoneImportDestroy checks that the import task is gone and
+// removes the imported zone, which is kept by the resource on destroy.
+func testAccCheckDNSV2ZoneImportDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_dns_zone_import_v2" {
+				continue
+			}
+
+			_, err := dnsZoneImportV2Get(ctx, dnsClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Zone import still exists")
+			}
+
+			if zoneID := rs.Primary.Attributes["zone_id"]; zoneID != "" {
+				_, err := zones.Delete(ctx, dnsClient, zoneID).Extract()
+				if err != nil {
+					return fmt.Errorf("Error deleting imported zone %s: %w", zoneID, err)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDNSV2ZoneImportBasic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "openstack_dns_zone_import_v2" "import_1" {
+  zone_file = <<-EOT
+    $ORIGIN %[1]s
+    $TTL 3000
+    %[1]s IN SOA ns1.%[1]s email.example.com. 1 3600 600 86400 3600
+    %[1]s IN NS ns1.%[1]s
+    www.%[1]s IN A 192.0.2.10
+  EOT
+}
+
+data "openstack_dns_zone_v2" "zone_1" {
+  name = "%[1]s"
+
+  depends_on = [openstack_dns_zone_import_v2.import_1]
+}
+`, zoneName)
+}
@@ -0,0 +1,297 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDNSZoneRecordSetsV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneRecordSetsV2Create,
+		ReadContext:   resourceDNSZoneRecordSetsV2Read,
+		UpdateContext: resourceDNSZoneRecordSetsV2Update,
+		DeleteContext: resourceDNSZoneRecordSetsV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+			return dnsZoneRecordSetsV2Validate(d.Get("recordset").(*schema.Set).List(), d.Get("ignore").(*schema.Set).List())
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"recordset": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDNSZoneRecordSetsV2Name,
+						},
+
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDNSZoneRecordSetsV2Type,
+						},
+
+						"records": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"ttl": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"ignore": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDNSZoneRecordSetsV2Type,
+						},
+					},
+				},
+			},
+
+			"disable_status_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func validateDNSZoneRecordSetsV2Name(v any, k string) ([]string, []error) {
+	if name := v.(string); !strings.HasSuffix(name, ".") {
+		return nil, []error{fmt.Errorf("%q must be a fully qualified name ending with a dot, got: %s", k, name)}
+	}
+
+	return nil, nil
+}
+
+func validateDNSZoneRecordSetsV2Type(v any, k string) ([]string, []error) {
+	if rsType := v.(string); rsType != strings.ToUpper(rsType) {
+		return nil, []error{fmt.Errorf("%q must be upper case, got: %s", k, rsType)}
+	}
+
+	return nil, nil
+}
+
+func resourceDNSZoneRecordSetsV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(ctx, d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	ignore := d.Get("ignore").(*schema.Set).List()
+
+	current, err := listDNSZoneRecordSetsV2(ctx, dnsClient, zoneID, ignore)
+	if err != nil {
+		return diag.Errorf("Error listing recordsets of openstack_dns_zone_v2 %s: %s", zoneID, err)
+	}
+
+	desired := expandDNSZoneRecordSetsV2(d.Get("recordset").(*schema.Set).List())
+	changes := dnsZoneRecordSetsV2Diff(desired, current)
+
+	log.Printf("[DEBUG] openstack_dns_zone_recordsets_v2 %s changes: %#v", zoneID, changes)
+
+	err = applyDNSZoneRecordSetsV2(ctx, dnsClient, zoneID, changes, d.Get("disable_status_check").(bool), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_zone_recordsets_v2 %s: %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceDNSZoneRecordSetsV2Read(ctx, d, meta)
+}
+
+func resourceDNSZoneRecordSetsV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(ctx, d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	current, err := listDNSZoneRecordSetsV2(ctx, dnsClient, d.Id(), d.Get("ignore").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_zone_recordsets_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_zone_recordsets_v2 %s: %#v", d.Id(), current)
+
+	var diags diag.Diagnostics
+
+	// zone_id is empty right after an import, when all recordsets are
+	// expected to be unknown.
+	if d.Get("zone_id").(string) != "" {
+		managed := make(map[string]bool)
+		for _, v := range d.Get("recordset").(*schema.Set).List() {
+			rs := v.(map[string]any)
+			managed[dnsZoneRecordSetV2Key(rs["name"].(string), rs["type"].(string))] = true
+		}
+
+		var unmanaged []string
+
+		for _, rs := range current {
+			if !managed[dnsZoneRecordSetV2Key(rs.Name, rs.Type)] {
+				unmanaged = append(unmanaged, rs.Name+" "+rs.Type)
+			}
+		}
+
+		if len(unmanaged) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unmanaged recordsets found",
+				Detail: fmt.Sprintf("openstack_dns_zone_recordsets_v2 %s found recordsets which are not managed by its recordset blocks: %s. "+
+					"They will be deleted on the next apply unless they are added to a recordset or an ignore block.",
+					d.Id(), strings.Join(unmanaged, ", ")),
+			})
+		}
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("zone_id", d.Id())
+	d.Set("recordset", flattenDNSZoneRecordSetsV2(current, expandDNSZoneRecordSetsV2(d.Get("recordset").(*schema.Set).List())))
+
+	return diags
+}
+
+func resourceDNSZoneRecordSetsV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(ctx, d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	if d.HasChanges("recordset", "ignore") {
+		current, err := listDNSZoneRecordSetsV2(ctx, dnsClient, d.Id(), d.Get("ignore").(*schema.Set).List())
+		if err != nil {
+			return diag.Errorf("Error listing recordsets of openstack_dns_zone_v2 %s: %s", d.Id(), err)
+		}
+
+		desired := expandDNSZoneRecordSetsV2(d.Get("recordset").(*schema.Set).List())
+		changes := dnsZoneRecordSetsV2Diff(desired, current)
+
+		log.Printf("[DEBUG] openstack_dns_zone_recordsets_v2 %s changes: %#v", d.Id(), changes)
+
+		err = applyDNSZoneRecordSetsV2(ctx, dnsClient, d.Id(), changes, d.Get("disable_status_check").(bool), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error updating openstack_dns_zone_recordsets_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDNSZoneRecordSetsV2Read(ctx, d, meta)
+}
+
+func resourceDNSZoneRecordSetsV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(ctx, d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	current, err := listDNSZoneRecordSetsV2(ctx, dnsClient, d.Id(), d.Get("ignore").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_zone_recordsets_v2"))
+	}
+
+	// Only the recordsets known to the state are deleted.
+	managed := make(map[string]bool)
+	for _, v := range d.Get("recordset").(*schema.Set).List() {
+		rs := v.(map[string]any)
+		managed[dnsZoneRecordSetV2Key(rs["name"].(string), rs["type"].(string))] = true
+	}
+
+	var changes dnsZoneRecordSetsV2Changes
+
+	for _, rs := range current {
+		if managed[dnsZoneRecordSetV2Key(rs.Name, rs.Type)] {
+			changes.Delete = append(changes.Delete, rs)
+		}
+	}
+
+	err = applyDNSZoneRecordSetsV2(ctx, dnsClient, d.Id(), changes, d.Get("disable_status_check").(bool), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("Error deleting openstack_dns_zone_recordsets_v2 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/zones"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSV2ZoneRecordSets_basic(t *testing.T) {
+	var zone zones.Zone
+
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2ZoneDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ZoneRecordSetsBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists(t.Context(), "openstack_dns_zone_v2.zone_1", &zone),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_zone_recordsets_v2.recordsets_1", "recordset.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"openstack_dns_zone_recordsets_v2.recordsets_1", "recordset.*", map[string]string{
+							"name":      "www." + zoneName,
+							"type":      "A",
+							"ttl":       "3000",
+							"records.#": "1",
+						}),
+				),
+			},
+			{
+				Config: testAccDNSV2ZoneRecordSetsUpdate(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_zone_recordsets_v2.recordsets_1", "recordset.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"openstack_dns_zone_recordsets_v2.recordsets_1", "recordset.*", map[string]string{
+							"name":      "www." + zoneName,
+							"type":      "A",
+							"ttl":       "6000",
+							"records.#": "2",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"openstack_dns_zone_recordsets_v2.recordsets_1", "recordset.*", map[string]string{
+							"name": "mail." + zoneName,
+							"type": "A",
+						}),
+					resource.TestCheckResourceAttrSet(
+						"openstack_dns_recordset_v2.ignored_1", "id"),
+				),
+			},
+			{
+				ResourceName:            "openstack_dns_zone_recordsets_v2.recordsets_1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_status_check", "ignore", "recordset"},
+			},
+		},
+	})
+}
+
+func testAccDNSV2ZoneRecordSetsBasic(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%[1]s"
+			email = "email2@example.com"
+			description = "a zone"
+			ttl = 6000
+			type = "PRIMARY"
+		}
+
+		resource "openstack_dns_zone_recordsets_v2" "recordsets_1" {
+			zone_id = openstack_dns_zone_v2.zone_1.id
+
+			recordset {
+				name = "www.%[1]s"
+				type = "A"
+				ttl = 3000
+				records = ["10.1.0.1"]
+			}
+
+			recordset {
+				name = "%[1]s"
+				type = "TXT"
+				description = "a TXT record set"
+				records = ["\"v=spf1 -all\""]
+			}
+		}
+	`, zoneName)
+}
+
+func testAccDNSV2ZoneRecordSetsUpdate(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%[1]s"
+			email = "email2@example.com"
+			description = "a zone"
+			ttl = 6000
+			type = "PRIMARY"
+		}
+
+		resource "openstack_dns_zone_recordsets_v2" "recordsets_1" {
+			zone_id = openstack_dns_zone_v2.zone_1.id
+
+			recordset {
+				name = "www.%[1]s"
+				type = "A"
+				ttl = 6000
+				records = ["10.1.0.1", "10.1.0.2"]
+			}
+
+			recordset {
+				name = "mail.%[1]s"
+				type = "A"
+				records = ["10.1.0.3"]
+			}
+
+			ignore {
+				name = "*.external.%[1]s"
+			}
+		}
+
+		resource "openstack_dns_recordset_v2" "ignored_1" {
+			zone_id = openstack_dns_zone_v2.zone_1.id
+			name = "app.external.%[1]s"
+			type = "A"
+			records = ["10.1.0.4"]
+
+			depends_on = [openstack_dns_zone_recordsets_v2.recordsets_1]
+		}
+	`, zoneName)
+}
@@ -0,0 +1,224 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdentityDomainV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityDomainV3Create,
+		ReadContext:   resourceIdentityDomainV3Read,
+		UpdateContext: resourceIdentityDomainV3Update,
+		DeleteContext: resourceIdentityDomainV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"immutable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIdentityDomainV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := identityDomainV3CreateOpts{
+		CreateOpts: domains.CreateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+			Enabled:     &enabled,
+		},
+		Tags: expandToStringSlice(d.Get("tags").(*schema.Set).List()),
+	}
+
+	if v := d.Get("options").([]any); len(v) > 0 {
+		createOpts.Options = expandIdentityDomainV3Options(v)
+	}
+
+	log.Printf("[DEBUG] openstack_identity_domain_v3 create options: %#v", createOpts)
+
+	domain, err := domains.Create(ctx, identityClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_domain_v3: %s", err)
+	}
+
+	d.SetId(domain.ID)
+
+	return resourceIdentityDomainV3Read(ctx, d, meta)
+}
+
+func resourceIdentityDomainV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	domain, err := identityDomainV3Get(ctx, identityClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_domain_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_domain_v3 %s: %#v", d.Id(), domain)
+
+	d.Set("name", domain.Name)
+	d.Set("description", domain.Description)
+	d.Set("enabled", domain.Enabled)
+	d.Set("tags", domain.Tags)
+	d.Set("options", flattenIdentityDomainV3Options(domain.Options, len(d.Get("options").([]any)) > 0))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityDomainV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+
+	var updateOpts identityDomainV3UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if d.HasChange("tags") {
+		hasChange = true
+		tags := expandToStringSlice(d.Get("tags").(*schema.Set).List())
+		updateOpts.Tags = &tags
+	}
+
+	if d.HasChange("options") {
+		hasChange = true
+		updateOpts.Options = expandIdentityDomainV3Options(d.Get("options").([]any))
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_identity_domain_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err := domains.Update(ctx, identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_domain_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityDomainV3Read(ctx, d, meta)
+}
+
+func resourceIdentityDomainV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	// Immutable domains can't be disabled, so the option is unset first.
+	if immutable, _ := expandIdentityDomainV3Options(d.Get("options").([]any))["immutable"].(bool); immutable {
+		log.Printf("[DEBUG] Unsetting immutable option of openstack_identity_domain_v3 %s", d.Id())
+
+		updateOpts := identityDomainV3UpdateOpts{
+			Options: expandIdentityDomainV3Options(nil),
+		}
+
+		_, err = domains.Update(ctx, identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "Error unsetting immutable option of openstack_identity_domain_v3"))
+		}
+	}
+
+	// Keystone only deletes disabled domains.
+	log.Printf("[DEBUG] Disabling openstack_identity_domain_v3 %s", d.Id())
+
+	updateOpts := identityDomainV3UpdateOpts{
+		UpdateOpts: domains.UpdateOpts{
+			Enabled: new(bool),
+		},
+	}
+
+	_, err = domains.Update(ctx, identityClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error disabling openstack_identity_domain_v3"))
+	}
+
+	err = domains.Delete(ctx, identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_domain_v3"))
+	}
+
+	return nil
+}
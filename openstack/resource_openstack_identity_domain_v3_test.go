@@ -0,0 +1,144 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3Domain_basic(t *testing.T) {
+	domainName := "ACPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3DomainDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3DomainBasic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DomainExists(t.Context(), "openstack_identity_domain_v3.domain_1"),
+					resource.TestCheckResourceAttr("openstack_identity_domain_v3.domain_1", "name", domainName),
+					resource.TestCheckResourceAttr("openstack_identity_domain_v3.domain_1", "description", "A domain"),
+					resource.TestCheckResourceAttr("openstack_identity_domain_v3.domain_1", "enabled", "true"),
+					resource.TestCheckTypeSetElemAttr("openstack_identity_domain_v3.domain_1", "tags.*", "tag1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_project_v3.project_1", "domain_id", "openstack_identity_domain_v3.domain_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_user_v3.user_1", "domain_id", "openstack_identity_domain_v3.domain_1", "id"),
+				),
+			},
+			{
+				Config: testAccIdentityV3DomainUpdate(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DomainExists(t.Context(), "openstack_identity_domain_v3.domain_1"),
+					resource.TestCheckResourceAttr("openstack_identity_domain_v3.domain_1", "description", "An updated domain"),
+					resource.TestCheckResourceAttr("openstack_identity_domain_v3.domain_1", "tags.#", "2"),
+					resource.TestCheckResourceAttr("openstack_identity_domain_v3.domain_1", "options.0.immutable", "true"),
+				),
+			},
+			{
+				ResourceName:      "openstack_identity_domain_v3.domain_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3DomainDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_domain_v3" {
+				continue
+			}
+
+			_, err := identityDomainV3Get(ctx, identityClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Domain still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3DomainExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		found, err := identityDomainV3Get(ctx, identityClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Domain not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccIdentityV3DomainBasic(domainName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_domain_v3" "domain_1" {
+  name        = "%s"
+  description = "A domain"
+  tags        = ["tag1"]
+}
+
+resource "openstack_identity_project_v3" "project_1" {
+  name      = "project_1"
+  domain_id = openstack_identity_domain_v3.domain_1.id
+}
+
+resource "openstack_identity_user_v3" "user_1" {
+  name      = "user_1"
+  domain_id = openstack_identity_domain_v3.domain_1.id
+}
+`, domainName)
+}
+
+func testAccIdentityV3DomainUpdate(domainName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_domain_v3" "domain_1" {
+  name        = "%s"
+  description = "An updated domain"
+  tags        = ["tag1", "tag2"]
+
+  options {
+    immutable = true
+  }
+}
+`, domainName)
+}
@@ -0,0 +1,142 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLBV2AvailabilityZoneProfile_basic(t *testing.T) {
+	var p lbAvailabilityZoneProfileV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2AvailabilityZoneProfileDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLbV2AvailabilityZoneProfileInvalid,
+				ExpectError: regexp.MustCompile(`key "compute_zones" is not supported`),
+			},
+			{
+				Config: testAccCheckLbV2AvailabilityZoneProfile,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2AvailabilityZoneProfileExists(t.Context(), "openstack_lb_availability_zone_profile_v2.azp_1", &p),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "name", "azp_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "provider_name", "amphora"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "availability_zone_data", `{"compute_zone":"nova"}`),
+				),
+			},
+			{
+				Config: testAccCheckLbV2AvailabilityZoneProfileUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2AvailabilityZoneProfileExists(t.Context(), "openstack_lb_availability_zone_profile_v2.azp_1", &p),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "name", "azp_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_availability_zone_profile_v2.azp_1", "availability_zone_data", `{"compute_zone":"nova","volume_zone":"nova"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2AvailabilityZoneProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		lbClient, err := config.LoadBalancerV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_lb_availability_zone_profile_v2" {
+				continue
+			}
+
+			_, err := lbAvailabilityZoneProfileV2Get(ctx, lbClient, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("Availability zone profile still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLBV2AvailabilityZoneProfileExists(ctx context.Context, n string, p *lbAvailabilityZoneProfileV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		lbClient, err := config.LoadBalancerV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %w", err)
+		}
+
+		found, err := lbAvailabilityZoneProfileV2Get(ctx, lbClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Availability zone profile not found")
+		}
+
+		*p = *found
+
+		return nil
+	}
+}
+
+const testAccCheckLbV2AvailabilityZoneProfileInvalid = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name          = "azp_1"
+  provider_name = "amphora"
+  availability_zone_data = jsonencode({
+    "compute_zones": "nova",
+  })
+}
+`
+
+const testAccCheckLbV2AvailabilityZoneProfile = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name          = "azp_1"
+  provider_name = "amphora"
+  availability_zone_data = jsonencode({
+    "compute_zone": "nova",
+  })
+}
+`
+
+const testAccCheckLbV2AvailabilityZoneProfileUpdate = `
+resource "openstack_lb_availability_zone_profile_v2" "azp_1" {
+  name          = "azp_1_updated"
+  provider_name = "amphora"
+  availability_zone_data = jsonencode({
+    "compute_zone": "nova",
+    "volume_zone": "nova",
+  })
+}
+`
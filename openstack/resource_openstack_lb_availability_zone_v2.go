@@ -0,0 +1,176 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLoadBalancerAvailabilityZoneV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerAvailabilityZoneV2Create,
+		ReadContext:   resourceLoadBalancerAvailabilityZoneV2Read,
+		UpdateContext: resourceLoadBalancerAvailabilityZoneV2Update,
+		DeleteContext: resourceLoadBalancerAvailabilityZoneV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"availability_zone_profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerAvailabilityZoneV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	var enabled *bool
+
+	if v, ok := getOkExists(d, "enabled"); ok {
+		v := v.(bool)
+		enabled = &v
+	}
+
+	createOpts := lbAvailabilityZoneV2CreateOpts{
+		Name:                      d.Get("name").(string),
+		Description:               d.Get("description").(string),
+		AvailabilityZoneProfileID: d.Get("availability_zone_profile_id").(string),
+		Enabled:                   enabled,
+	}
+
+	log.Printf("[DEBUG] openstack_lb_availability_zone_v2 create options: %#v", createOpts)
+
+	az, err := lbAvailabilityZoneV2Create(ctx, lbClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_lb_availability_zone_v2: %s", err)
+	}
+
+	d.SetId(az.Name)
+
+	log.Printf("[DEBUG] Created openstack_lb_availability_zone_v2 %#v", az)
+
+	return resourceLoadBalancerAvailabilityZoneV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerAvailabilityZoneV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	az, err := lbAvailabilityZoneV2Get(ctx, lbClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_availability_zone_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_availability_zone_v2 %s: %#v", d.Id(), az)
+
+	d.Set("name", az.Name)
+	d.Set("description", az.Description)
+	d.Set("availability_zone_profile_id", az.AvailabilityZoneProfileID)
+	d.Set("enabled", az.Enabled)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceLoadBalancerAvailabilityZoneV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts lbAvailabilityZoneV2UpdateOpts
+	)
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_lb_availability_zone_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err := lbAvailabilityZoneV2Update(ctx, lbClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_lb_availability_zone_v2: %s", err)
+		}
+	}
+
+	return resourceLoadBalancerAvailabilityZoneV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerAvailabilityZoneV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancing client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting openstack_lb_availability_zone_v2: %s", d.Id())
+
+	if err := lbAvailabilityZoneV2Delete(ctx, lbClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_lb_availability_zone_v2"))
+	}
+
+	d.SetId("")
+
+	return nil
+}
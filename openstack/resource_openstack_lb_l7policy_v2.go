@@ -118,6 +118,54 @@ func resourceL7PolicyV2() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"COOKIE", "FILE_TYPE", "HEADER", "HOST_NAME",
+								"PATH", "SSL_CONN_HAS_CERT", "SSL_VERIFY_RESULT",
+								"SSL_DN_FIELD",
+							}, true),
+						},
+
+						"compare_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"CONTAINS", "STARTS_WITH", "ENDS_WITH", "EQUAL_TO", "REGEX",
+							}, true),
+						},
+
+						"key": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"invert": {
+							Type:     schema.TypeBool,
+							Default:  false,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -163,6 +211,12 @@ func resourceL7PolicyV2Create(ctx context.Context, d *schema.ResourceData, meta
 		createOpts.Position = int32(v.(int))
 	}
 
+	// Inline rules are sent in the same request as the L7 Policy.
+	createOpts.Rules, err = expandL7PolicyV2Rules(d.Get("rule").([]any))
+	if err != nil {
+		return diag.Errorf("Unable to create L7 Policy: %s", err)
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
@@ -246,6 +300,31 @@ func resourceL7PolicyV2Read(ctx context.Context, d *schema.ResourceData, meta an
 	d.Set("region", GetRegion(d, config))
 	d.Set("admin_state_up", l7Policy.AdminStateUp)
 
+	// Rules are only tracked when they are managed by the rule block,
+	// otherwise they belong to openstack_lb_l7rule_v2 resources.
+	stateRules := d.Get("rule").([]any)
+	if len(stateRules) == 0 {
+		return nil
+	}
+
+	apiRules, err := listL7PolicyV2Rules(ctx, lbClient, d.Id())
+	if err != nil {
+		return diag.Errorf("Unable to retrieve rules of L7 Policy %s: %s", d.Id(), err)
+	}
+
+	rules, unmanaged := flattenL7PolicyV2Rules(stateRules, apiRules)
+	d.Set("rule", rules)
+
+	if len(unmanaged) > 0 {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "L7 Policy has rules not managed by the rule block",
+				Detail:   l7PolicyV2RulesConflictMessage(d.Id(), unmanaged),
+			},
+		}
+	}
+
 	return nil
 }
 
@@ -352,24 +431,37 @@ func resourceL7PolicyV2Update(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] Updating L7 Policy %s with options: %#v", d.Id(), updateOpts)
+	if d.HasChangesExcept("rule") {
+		log.Printf("[DEBUG] Updating L7 Policy %s with options: %#v", d.Id(), updateOpts)
 
-	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		_, err = l7policies.Update(ctx, lbClient, d.Id(), updateOpts).Extract()
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			_, err = l7policies.Update(ctx, lbClient, d.Id(), updateOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
 		if err != nil {
-			return checkForRetryableError(err)
+			return diag.Errorf("Unable to update L7 Policy %s: %s", d.Id(), err)
 		}
 
-		return nil
-	})
-	if err != nil {
-		return diag.Errorf("Unable to update L7 Policy %s: %s", d.Id(), err)
+		// Wait for L7 Policy to become active before continuing
+		err = waitForLBV2L7Policy(ctx, lbClient, parentListener, l7Policy, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	// Wait for L7 Policy to become active before continuing
-	err = waitForLBV2L7Policy(ctx, lbClient, parentListener, l7Policy, "ACTIVE", getLbPendingStatuses(), timeout)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChange("rule") {
+		o, n := d.GetChange("rule")
+
+		rules, err := updateL7PolicyV2Rules(ctx, lbClient, parentListener, l7Policy, o.([]any), n.([]any), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("rule", rules)
 	}
 
 	return resourceL7PolicyV2Read(ctx, d, meta)
@@ -135,6 +135,84 @@ func TestAccLBV2L7Policy_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2L7Policy_rules(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2L7PolicyDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRules(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(t.Context(), "openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					testAccCheckLBV2L7PolicyRuleCount(t.Context(), &l7Policy, 2),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.0.type", "PATH"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.0.value", "/api"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.1.type", "HEADER"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.1.key", "X-Ref"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.0.id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.1.id"),
+				),
+			},
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRulesUpdate1(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(t.Context(), "openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					testAccCheckLBV2L7PolicyRuleCount(t.Context(), &l7Policy, 3),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.#", "3"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.0.compare_type", "EQUAL_TO"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.0.value", "/api/v2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.1.invert", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.2.type", "HOST_NAME"),
+				),
+			},
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRulesUpdate2(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(t.Context(), "openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					testAccCheckLBV2L7PolicyRuleCount(t.Context(), &l7Policy, 1),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.0.value", "/api/v2"),
+				),
+			},
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRulesStandalone(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(t.Context(), "openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					testAccCheckLBV2L7PolicyRuleCount(t.Context(), &l7Policy, 2),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "rule.#", "1"),
+				),
+			},
+			{
+				Config:      testAccCheckLbV2L7PolicyConfigRulesConflict(),
+				ExpectError: regexp.MustCompile("not managed by its rule blocks"),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2L7PolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -192,6 +270,28 @@ func testAccCheckLBV2L7PolicyExists(ctx context.Context, n string, l7Policy *l7p
 	}
 }
 
+func testAccCheckLBV2L7PolicyRuleCount(ctx context.Context, l7Policy *l7policies.L7Policy, count int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		lbClient, err := config.LoadBalancerV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %w", err)
+		}
+
+		rules, err := listL7PolicyV2Rules(ctx, lbClient, l7Policy.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(rules) != count {
+			return fmt.Errorf("Expected %d rules on L7 Policy %s, got %d", count, l7Policy.ID, len(rules))
+		}
+
+		return nil
+	}
+}
+
 const testAccCheckLbV2L7PolicyConfig = `
 resource "openstack_networking_network_v2" "network_1" {
   name = "network_1"
@@ -336,3 +436,133 @@ resource "openstack_lb_l7policy_v2" "l7policy_1" {
 }
 `, testAccCheckLbV2L7PolicyConfig)
 }
+
+func testAccCheckLbV2L7PolicyConfigRules() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name        = "test"
+  action      = "REJECT"
+  position    = 1
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  rule {
+    type         = "PATH"
+    compare_type = "STARTS_WITH"
+    value        = "/api"
+  }
+
+  rule {
+    type         = "HEADER"
+    compare_type = "EQUAL_TO"
+    key          = "X-Ref"
+    value        = "foo"
+  }
+}
+`, testAccCheckLbV2L7PolicyConfig)
+}
+
+func testAccCheckLbV2L7PolicyConfigRulesUpdate1() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name        = "test"
+  action      = "REJECT"
+  position    = 1
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  rule {
+    type         = "PATH"
+    compare_type = "EQUAL_TO"
+    value        = "/api/v2"
+  }
+
+  rule {
+    type         = "HEADER"
+    compare_type = "EQUAL_TO"
+    key          = "X-Ref"
+    value        = "foo"
+    invert       = true
+  }
+
+  rule {
+    type         = "HOST_NAME"
+    compare_type = "ENDS_WITH"
+    value        = "example.com"
+  }
+}
+`, testAccCheckLbV2L7PolicyConfig)
+}
+
+func testAccCheckLbV2L7PolicyConfigRulesUpdate2() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name        = "test"
+  action      = "REJECT"
+  position    = 1
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  rule {
+    type         = "PATH"
+    compare_type = "EQUAL_TO"
+    value        = "/api/v2"
+  }
+}
+`, testAccCheckLbV2L7PolicyConfig)
+}
+
+func testAccCheckLbV2L7PolicyConfigRulesStandalone() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name        = "test"
+  action      = "REJECT"
+  position    = 1
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  rule {
+    type         = "PATH"
+    compare_type = "EQUAL_TO"
+    value        = "/api/v2"
+  }
+}
+
+resource "openstack_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id  = openstack_lb_l7policy_v2.l7policy_1.id
+  type         = "HOST_NAME"
+  compare_type = "EQUAL_TO"
+  value        = "www.example.com"
+}
+`, testAccCheckLbV2L7PolicyConfig)
+}
+
+func testAccCheckLbV2L7PolicyConfigRulesConflict() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name        = "test"
+  action      = "REJECT"
+  position    = 1
+  listener_id = openstack_lb_listener_v2.listener_1.id
+
+  rule {
+    type         = "PATH"
+    compare_type = "STARTS_WITH"
+    value        = "/api"
+  }
+}
+
+resource "openstack_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id  = openstack_lb_l7policy_v2.l7policy_1.id
+  type         = "HOST_NAME"
+  compare_type = "EQUAL_TO"
+  value        = "www.example.com"
+}
+`, testAccCheckLbV2L7PolicyConfig)
+}
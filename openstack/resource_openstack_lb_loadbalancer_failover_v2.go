@@ -0,0 +1,139 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/amphorae"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLoadBalancerFailoverV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerFailoverV2Create,
+		ReadContext:   resourceLoadBalancerFailoverV2Read,
+		DeleteContext: resourceLoadBalancerFailoverV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"amphora_id"},
+			},
+
+			"amphora_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"loadbalancer_id"},
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerFailoverV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	lbID := d.Get("loadbalancer_id").(string)
+	amphoraID := d.Get("amphora_id").(string)
+
+	if amphoraID != "" {
+		amphora, err := amphorae.Get(ctx, lbClient, amphoraID).Extract()
+		if err != nil {
+			return diag.Errorf("Unable to retrieve openstack_lb_loadbalancer_failover_v2 amphora %s: %s", amphoraID, err)
+		}
+
+		lbID = amphora.LoadbalancerID
+	}
+
+	// Octavia refuses a failover while the load balancer is immutable.
+	err = waitForLBV2LoadBalancer(ctx, lbClient, lbID, "ACTIVE", getLbPendingStatuses(), timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if amphoraID != "" {
+		log.Printf("[DEBUG] Triggering failover of openstack_lb_loadbalancer_failover_v2 amphora %s", amphoraID)
+
+		err = amphorae.Failover(ctx, lbClient, amphoraID).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error triggering failover of amphora %s: %s", amphoraID, err)
+		}
+
+		d.SetId(amphoraID)
+	} else {
+		log.Printf("[DEBUG] Triggering failover of openstack_lb_loadbalancer_failover_v2 loadbalancer %s", lbID)
+
+		err = loadbalancers.Failover(ctx, lbClient, lbID).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error triggering failover of loadbalancer %s: %s", lbID, err)
+		}
+
+		d.SetId(lbID)
+	}
+
+	d.Set("loadbalancer_id", lbID)
+
+	err = waitForLBV2LoadBalancer(ctx, lbClient, lbID, "ACTIVE", getLbPendingStatuses(), timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceLoadBalancerFailoverV2Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerFailoverV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	// A failed over amphora is replaced by a new one, so only the load
+	// balancer is expected to outlive the failover.
+	lbID := d.Get("loadbalancer_id").(string)
+
+	_, err = loadbalancers.Get(ctx, lbClient, lbID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_lb_loadbalancer_failover_v2 loadbalancer"))
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceLoadBalancerFailoverV2Delete(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+	// A failover can't be reverted, there is nothing to undo.
+	return nil
+}
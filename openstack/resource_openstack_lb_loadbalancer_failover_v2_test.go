@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2LoadBalancerFailover_basic(t *testing.T) {
+	var lb loadbalancers.LoadBalancer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2LoadBalancerFailoverConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_loadbalancer_failover_v2.failover_1", "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "provisioning_status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccLBV2LoadBalancerFailoverConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_failover_v2.failover_1", "triggers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2LoadBalancerFailover_amphora(t *testing.T) {
+	var lb loadbalancers.LoadBalancer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2LoadBalancerFailoverAmphora,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_loadbalancer_failover_v2.failover_1", "loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_loadbalancer_failover_v2.failover_1", "amphora_id",
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBV2LoadBalancerFailoverLoadBalancer = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+`
+
+func testAccLBV2LoadBalancerFailoverConfig(rotation string) string {
+	return testAccLBV2LoadBalancerFailoverLoadBalancer + `
+resource "openstack_lb_loadbalancer_failover_v2" "failover_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+
+  triggers = {
+    rotation = "` + rotation + `"
+  }
+}
+`
+}
+
+const testAccLBV2LoadBalancerFailoverAmphora = testAccLBV2LoadBalancerFailoverLoadBalancer + `
+data "openstack_lb_amphorae_v2" "amphorae_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_loadbalancer_failover_v2" "failover_1" {
+  amphora_id = data.openstack_lb_amphorae_v2.amphorae_1.amphorae.0.id
+}
+`
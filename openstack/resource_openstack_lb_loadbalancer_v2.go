@@ -7,8 +7,10 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceLoadBalancerV2() *schema.Resource {
@@ -18,7 +20,7 @@ func resourceLoadBalancerV2() *schema.Resource {
 		UpdateContext: resourceLoadBalancerV2Update,
 		DeleteContext: resourceLoadBalancerV2Delete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceLoadBalancerV2Import,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -27,6 +29,13 @@ func resourceLoadBalancerV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			resourceLoadBalancerV2CustomizeDiff,
+			func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+				return lbV2ValidateFullyPopulated(d.Get("listener").([]any), d.Get("pool").([]any))
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -137,6 +146,248 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"cascade_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"cleanup_vip_port": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"listener": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"TCP", "UDP", "SCTP", "HTTP", "HTTPS", "TERMINATED_HTTPS", "PROMETHEUS",
+							}, false),
+						},
+
+						"protocol_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+
+						"default_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"connection_limit": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Default:  true,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"pool": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"TCP", "UDP", "HTTP", "HTTPS", "PROXY", "SCTP", "PROXYV2",
+							}, false),
+						},
+
+						"lb_method": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ROUND_ROBIN", "LEAST_CONNECTIONS", "SOURCE_IP", "SOURCE_IP_PORT",
+							}, false),
+						},
+
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Default:  true,
+							Optional: true,
+						},
+
+						"member": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"address": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"protocol_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+
+									"weight": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntBetween(0, 256),
+									},
+
+									"monitor_port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+
+									"monitor_address": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"backup": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+
+									"admin_state_up": {
+										Type:     schema.TypeBool,
+										Default:  true,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"healthmonitor": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"HTTP", "HTTPS", "PING", "SCTP", "TCP",
+											"TLS-HELLO", "UDP-CONNECT",
+										}, false),
+									},
+
+									"delay": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+
+									"timeout": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+
+									"max_retries": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+
+									"max_retries_down": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+
+									"url_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+
+									"http_method": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+
+									"expected_codes": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+
+									"admin_state_up": {
+										Type:     schema.TypeBool,
+										Default:  true,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -186,9 +437,20 @@ func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m
 		createOpts.Tags = expandToStringSlice(tags)
 	}
 
-	log.Printf("[DEBUG] openstack_lb_loadbalancer_v2 create options: %#v", createOpts)
+	// Listeners and pools are created together with the load-balancer in a
+	// single fully populated request.
+	var createOptsBuilder loadbalancers.CreateOptsBuilder = createOpts
 
-	lb, err := loadbalancers.Create(ctx, lbClient, createOpts).Extract()
+	allListeners, allPools := d.Get("listener").([]any), d.Get("pool").([]any)
+	if len(allListeners) > 0 || len(allPools) > 0 {
+		fullOpts := lbV2FullyPopulatedCreateOpts{CreateOpts: createOpts}
+		fullOpts.Listeners, fullOpts.Pools, fullOpts.DefaultPools = expandLBFullyPopulatedV2(allListeners, allPools)
+		createOptsBuilder = fullOpts
+	}
+
+	log.Printf("[DEBUG] openstack_lb_loadbalancer_v2 create options: %#v", createOptsBuilder)
+
+	lb, err := loadbalancers.Create(ctx, lbClient, createOptsBuilder).Extract()
 	if err != nil {
 		return diag.Errorf("Error creating openstack_lb_loadbalancer_v2: %s", err)
 	}
@@ -255,6 +517,10 @@ func resourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, met
 
 	vipPortID = lb.VipPortID
 
+	if err := resourceLoadBalancerV2ReadFullyPopulated(ctx, lbClient, d); err != nil {
+		return diag.Errorf("Error retrieving openstack_lb_loadbalancer_v2 %s listeners and pools: %s", d.Id(), err)
+	}
+
 	// Get any security groups on the VIP Port.
 	if vipPortID != "" {
 		networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
@@ -348,6 +614,20 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// Listeners and pools get updated separately.
+	if d.HasChanges("listener", "pool") {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+
+		err = waitForLBV2LoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := resourceLoadBalancerV2UpdateFullyPopulated(ctx, lbClient, d, timeout); err != nil {
+			return diag.Errorf("Error updating openstack_lb_loadbalancer_v2 %s listeners and pools: %s", d.Id(), err)
+		}
+	}
+
 	// Security Groups get updated separately.
 	if d.HasChange("security_group_ids") {
 		networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
@@ -372,11 +652,20 @@ func resourceLoadBalancerV2Delete(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	log.Printf("[DEBUG] Deleting openstack_lb_loadbalancer_v2 %s", d.Id())
+	// Listeners, pools and other children, which were created outside of
+	// Terraform, prevent the deletion unless it is cascaded. The ones from
+	// the listener and pool blocks are always deleted with the load-balancer.
+	cascade := d.Get("cascade_delete").(bool) ||
+		len(d.Get("listener").([]any)) > 0 || len(d.Get("pool").([]any)) > 0
+	deleteOpts := loadbalancers.DeleteOpts{
+		Cascade: cascade,
+	}
+
+	log.Printf("[DEBUG] Deleting openstack_lb_loadbalancer_v2 %s with options: %#v", d.Id(), deleteOpts)
 	timeout := d.Timeout(schema.TimeoutDelete)
 
 	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		err = loadbalancers.Delete(ctx, lbClient, d.Id(), loadbalancers.DeleteOpts{}).ExtractErr()
+		err = loadbalancers.Delete(ctx, lbClient, d.Id(), deleteOpts).ExtractErr()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -393,5 +682,31 @@ func resourceLoadBalancerV2Delete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	// A vip_port_id from the configuration is never cleaned up. Terraform
+	// doesn't send the configuration on destroy, so the device owner of the
+	// port is checked as well.
+	vipPortConfigured := false
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		vipPortConfigured = !rawConfig.GetAttr("vip_port_id").IsNull()
+	}
+
+	if vipPortID := d.Get("vip_port_id").(string); vipPortID != "" && d.Get("cleanup_vip_port").(bool) && !vipPortConfigured {
+		networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+		if err != nil {
+			return diag.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		if err := resourceLoadBalancerV2CleanupVIPPort(ctx, networkingClient, vipPortID); err != nil {
+			return diag.Errorf("Error cleaning up openstack_lb_loadbalancer_v2 %s VIP port %s: %s", d.Id(), vipPortID, err)
+		}
+	}
+
 	return nil
 }
+
+func resourceLoadBalancerV2Import(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	d.Set("cascade_delete", false)
+	d.Set("cleanup_vip_port", false)
+
+	return []*schema.ResourceData{d}, nil
+}
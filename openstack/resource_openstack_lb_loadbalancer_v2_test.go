@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
@@ -165,6 +167,131 @@ func TestAccLBV2LoadBalancer_vip_port_id(t *testing.T) {
 	})
 }
 
+func TestAccLBV2LoadBalancer_cascadeDelete(t *testing.T) {
+	var lb loadbalancers.LoadBalancer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2LoadBalancerConfigCascadeDelete,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "cascade_delete", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "cleanup_vip_port", "true"),
+					// The listener is not managed by Terraform and is only
+					// removed by the cascade delete.
+					testAccCheckLBV2LoadBalancerCreateListener(t.Context(), &lb),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2LoadBalancer_fullyPopulated(t *testing.T) {
+	var lb loadbalancers.LoadBalancer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2LoadBalancerConfigFullyPopulated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.id"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.default_pool_name", "pool_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.0.id"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.0.member.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.0.healthmonitor.0.type", "HTTP"),
+				),
+			},
+			{
+				Config: testAccLbV2LoadBalancerConfigFullyPopulatedUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.description", "updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.1.default_pool_name", "pool_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.0.lb_method", "LEAST_CONNECTIONS"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.0.member.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.0.healthmonitor.0.delay", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.1.healthmonitor.#", "0"),
+				),
+			},
+			{
+				Config: testAccLbV2LoadBalancerConfigFullyPopulatedUpdate2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists(t.Context(), "openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "listener.0.default_pool_name", "pool_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "pool.0.name", "pool_2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2LoadBalancerCreateListener(ctx context.Context, lb *loadbalancers.LoadBalancer) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		lbClient, err := config.LoadBalancerV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %w", err)
+		}
+
+		createOpts := listeners.CreateOpts{
+			Name:           "unmanaged_listener",
+			Protocol:       listeners.ProtocolHTTP,
+			ProtocolPort:   8080,
+			LoadbalancerID: lb.ID,
+		}
+
+		if _, err := listeners.Create(ctx, lbClient, createOpts).Extract(); err != nil {
+			return err
+		}
+
+		return waitForLBV2LoadBalancer(ctx, lbClient, lb.ID, "ACTIVE", getLbPendingStatuses(), 10*time.Minute)
+	}
+}
+
 func testAccCheckLBV2LoadBalancerDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -550,3 +677,204 @@ resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
   }
 }
 `
+
+const testAccLbV2LoadBalancerConfigCascadeDelete = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  loadbalancer_provider = "octavia"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  cascade_delete = true
+  cleanup_vip_port = true
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+`
+
+const testAccLbV2LoadBalancerConfigFullyPopulated = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  listener {
+    name = "listener_1"
+    protocol = "HTTP"
+    protocol_port = 8080
+    default_pool_name = "pool_1"
+  }
+
+  pool {
+    name = "pool_1"
+    protocol = "HTTP"
+    lb_method = "ROUND_ROBIN"
+
+    member {
+      address = "192.168.199.110"
+      protocol_port = 8080
+      subnet_id = openstack_networking_subnet_v2.subnet_1.id
+    }
+
+    member {
+      address = "192.168.199.111"
+      protocol_port = 8080
+      subnet_id = openstack_networking_subnet_v2.subnet_1.id
+    }
+
+    healthmonitor {
+      type = "HTTP"
+      delay = 10
+      timeout = 5
+      max_retries = 3
+      url_path = "/health"
+    }
+  }
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+`
+
+const testAccLbV2LoadBalancerConfigFullyPopulatedUpdate = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  listener {
+    name = "listener_1"
+    description = "updated"
+    protocol = "HTTP"
+    protocol_port = 8080
+    default_pool_name = "pool_1"
+  }
+
+  listener {
+    name = "listener_2"
+    protocol = "TCP"
+    protocol_port = 8443
+    default_pool_name = "pool_2"
+  }
+
+  pool {
+    name = "pool_1"
+    protocol = "HTTP"
+    lb_method = "LEAST_CONNECTIONS"
+
+    member {
+      address = "192.168.199.110"
+      protocol_port = 8080
+      subnet_id = openstack_networking_subnet_v2.subnet_1.id
+    }
+
+    healthmonitor {
+      type = "HTTP"
+      delay = 20
+      timeout = 5
+      max_retries = 3
+      url_path = "/health"
+    }
+  }
+
+  pool {
+    name = "pool_2"
+    protocol = "TCP"
+    lb_method = "ROUND_ROBIN"
+
+    member {
+      address = "192.168.199.112"
+      protocol_port = 8443
+      subnet_id = openstack_networking_subnet_v2.subnet_1.id
+    }
+  }
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+`
+
+const testAccLbV2LoadBalancerConfigFullyPopulatedUpdate2 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  listener {
+    name = "listener_2"
+    protocol = "TCP"
+    protocol_port = 8443
+    default_pool_name = "pool_2"
+  }
+
+  pool {
+    name = "pool_2"
+    protocol = "TCP"
+    lb_method = "ROUND_ROBIN"
+
+    member {
+      address = "192.168.199.112"
+      protocol_port = 8443
+      subnet_id = openstack_networking_subnet_v2.subnet_1.id
+    }
+  }
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+`
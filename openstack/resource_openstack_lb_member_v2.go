@@ -114,6 +114,19 @@ func resourceMemberV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"drain": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 	}
 }
@@ -247,6 +260,11 @@ func resourceMemberV2Read(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceMemberV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// drain and drain_timeout are only used on delete.
+	if !d.HasChangesExcept("drain", "drain_timeout") {
+		return resourceMemberV2Read(ctx, d, meta)
+	}
+
 	config := meta.(*Config)
 
 	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
@@ -375,6 +393,37 @@ func resourceMemberV2Delete(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(CheckDeleted(d, err, "Error waiting for the members pool status"))
 	}
 
+	if d.Get("drain").(bool) && member.Weight != 0 {
+		weight := 0
+		updateOpts := pools.UpdateMemberOpts{Weight: &weight}
+
+		log.Printf("[DEBUG] Draining member %s", d.Id())
+
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			_, err = pools.UpdateMember(ctx, lbClient, poolID, d.Id(), updateOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "Error draining member"))
+		}
+
+		err = waitForLBV2Member(ctx, lbClient, parentPool, member, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		drainTimeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second
+
+		err = lbV2WaitForMembersDrain(ctx, lbClient, parentPool, drainTimeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	log.Printf("[DEBUG] Attempting to delete member %s", d.Id())
 
 	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
@@ -411,6 +460,8 @@ func resourceMemberV2Import(_ context.Context, d *schema.ResourceData, _ any) ([
 
 	d.SetId(memberID)
 	d.Set("pool_id", poolID)
+	d.Set("drain", false)
+	d.Set("drain_timeout", 60)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -54,6 +54,38 @@ func TestAccLBV2Member_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Member_drain(t *testing.T) {
+	var member pools.Member
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2MemberDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccLbV2MemberDrain,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MemberExists(t.Context(), "openstack_lb_member_v2.member_1", &member),
+					resource.TestCheckResourceAttr("openstack_lb_member_v2.member_1", "weight", "1"),
+					resource.TestCheckResourceAttr("openstack_lb_member_v2.member_1", "drain", "true"),
+					resource.TestCheckResourceAttr("openstack_lb_member_v2.member_1", "drain_timeout", "10"),
+				),
+			},
+			{
+				Config: TestAccLbV2MemberDrainUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MemberExists(t.Context(), "openstack_lb_member_v2.member_1", &member),
+					resource.TestCheckResourceAttr("openstack_lb_member_v2.member_1", "drain_timeout", "5"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLBV2Member_monitor(t *testing.T) {
 	var member1 pools.Member
 
@@ -514,3 +546,64 @@ resource "openstack_lb_member_v2" "member_2" {
   }
 }
 `
+
+const testAccLbV2MemberDrainPool = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = openstack_networking_network_v2.network_1.id
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+}
+`
+
+const TestAccLbV2MemberDrain = testAccLbV2MemberDrainPool + `
+resource "openstack_lb_member_v2" "member_1" {
+  address = "192.168.199.110"
+  protocol_port = 8080
+  pool_id = openstack_lb_pool_v2.pool_1.id
+  subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  drain = true
+  drain_timeout = 10
+}
+`
+
+const TestAccLbV2MemberDrainUpdate = testAccLbV2MemberDrainPool + `
+resource "openstack_lb_member_v2" "member_1" {
+  address = "192.168.199.110"
+  protocol_port = 8080
+  pool_id = openstack_lb_pool_v2.pool_1.id
+  subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  drain = true
+  drain_timeout = 5
+}
+`
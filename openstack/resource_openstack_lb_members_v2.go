@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -20,7 +21,7 @@ func resourceMembersV2() *schema.Resource {
 		UpdateContext: resourceMembersV2Update,
 		DeleteContext: resourceMembersV2Delete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceMembersV2Import,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -105,6 +106,19 @@ func resourceMembersV2() *schema.Resource {
 					},
 				},
 			},
+
+			"drain": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 	}
 }
@@ -196,7 +210,9 @@ func resourceMembersV2Update(ctx context.Context, d *schema.ResourceData, meta a
 	}
 
 	if d.HasChange("member") {
-		updateOpts := expandLBMembersV2(d.Get("member").(*schema.Set))
+		o, n := d.GetChange("member")
+		newMembers := n.(*schema.Set)
+		updateOpts := expandLBMembersV2(newMembers)
 
 		// Get a clean copy of the parent pool.
 		parentPool, err := pools.Get(ctx, lbClient, d.Id()).Extract()
@@ -212,6 +228,19 @@ func resourceMembersV2Update(ctx context.Context, d *schema.ResourceData, meta a
 			return diag.FromErr(err)
 		}
 
+		if d.Get("drain").(bool) {
+			// Keep the removed members with a weight of 0 until they're drained.
+			drain := lbV2MembersToDrain(o.(*schema.Set), newMembers)
+			if len(drain) > 0 {
+				drainOpts := expandLBMembersV2(schema.NewSet(newMembers.F, append(newMembers.List(), drain...)))
+
+				err = resourceMembersV2Drain(ctx, d, lbClient, parentPool, drainOpts, timeout)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
 		log.Printf("[DEBUG] Updating %s pool members with options: %#v", d.Id(), updateOpts)
 
 		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
@@ -258,6 +287,20 @@ func resourceMembersV2Delete(ctx context.Context, d *schema.ResourceData, meta a
 		return diag.FromErr(CheckDeleted(d, err, "Error waiting for the members' pool status"))
 	}
 
+	if d.Get("drain").(bool) {
+		members := d.Get("member").(*schema.Set)
+
+		drain := lbV2MembersToDrain(members, schema.NewSet(members.F, nil))
+		if len(drain) > 0 {
+			drainOpts := expandLBMembersV2(schema.NewSet(members.F, drain))
+
+			err = resourceMembersV2Drain(ctx, d, lbClient, parentPool, drainOpts, timeout)
+			if err != nil {
+				return diag.FromErr(CheckDeleted(d, err, "Error draining members"))
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Attempting to delete %s pool members", d.Id())
 
 	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
@@ -280,3 +323,37 @@ func resourceMembersV2Delete(ctx context.Context, d *schema.ResourceData, meta a
 
 	return nil
 }
+
+// resourceMembersV2Drain applies drainOpts, which set the weight of the members
+// to remove to 0, and waits for their connections to drain.
+func resourceMembersV2Drain(ctx context.Context, d *schema.ResourceData, lbClient *gophercloud.ServiceClient, parentPool *pools.Pool, drainOpts []pools.BatchUpdateMemberOpts, timeout time.Duration) error {
+	log.Printf("[DEBUG] Draining %s pool members with options: %#v", d.Id(), drainOpts)
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err := pools.BatchUpdateMembers(ctx, lbClient, d.Id(), drainOpts).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to drain %s pool members: %w", d.Id(), err)
+	}
+
+	err = waitForLBV2Pool(ctx, lbClient, parentPool, "ACTIVE", getLbPendingStatuses(), timeout)
+	if err != nil {
+		return err
+	}
+
+	drainTimeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second
+
+	return lbV2WaitForMembersDrain(ctx, lbClient, parentPool, drainTimeout)
+}
+
+func resourceMembersV2Import(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	d.Set("drain", false)
+	d.Set("drain_timeout", 60)
+
+	return []*schema.ResourceData{d}, nil
+}
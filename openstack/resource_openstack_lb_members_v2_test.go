@@ -105,6 +105,54 @@ func TestAccLBV2Members_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Members_drain(t *testing.T) {
+	var members []pools.Member
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2MembersDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2MembersConfigDrain(`
+  member {
+    address = "192.168.199.110"
+    protocol_port = 8080
+  }
+
+  member {
+    address = "192.168.199.111"
+    protocol_port = 8080
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersExists(t.Context(), "openstack_lb_members_v2.members_1", &members),
+					resource.TestCheckResourceAttr("openstack_lb_members_v2.members_1", "member.#", "2"),
+					resource.TestCheckResourceAttr("openstack_lb_members_v2.members_1", "drain", "true"),
+					resource.TestCheckResourceAttr("openstack_lb_members_v2.members_1", "drain_timeout", "10"),
+				),
+			},
+			{
+				Config: testAccLbV2MembersConfigDrain(`
+  member {
+    address = "192.168.199.110"
+    protocol_port = 8080
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersExists(t.Context(), "openstack_lb_members_v2.members_1", &members),
+					resource.TestCheckResourceAttr("openstack_lb_members_v2.members_1", "member.#", "1"),
+					resource.TestCheckResourceAttr("openstack_lb_members_v2.members_1", "member.0.address", "192.168.199.110"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2MembersDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -406,3 +454,50 @@ resource "openstack_lb_members_v2" "members_1" {
   }
 }
 `
+
+func testAccLbV2MembersConfigDrain(members string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = openstack_lb_listener_v2.listener_1.id
+}
+
+resource "openstack_lb_members_v2" "members_1" {
+  pool_id       = openstack_lb_pool_v2.pool_1.id
+  drain         = true
+  drain_timeout = 10
+%s
+  timeouts {
+    create = "10m"
+    update = "10m"
+    delete = "10m"
+  }
+}
+`, members)
+}
@@ -0,0 +1,196 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingDefaultSecGroupRuleV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingDefaultSecGroupRuleV2Create,
+		ReadContext:   resourceNetworkingDefaultSecGroupRuleV2Read,
+		DeleteContext: resourceNetworkingDefaultSecGroupRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceNetworkingSecGroupRuleV2Direction,
+			},
+
+			"ethertype": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceNetworkingSecGroupRuleV2EtherType,
+			},
+
+			"port_range_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"protocol", "port_range_max"},
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+
+			"port_range_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"protocol", "port_range_min"},
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceNetworkingSecGroupRuleV2Protocol,
+			},
+
+			"remote_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"remote_ip_prefix", "remote_address_group_id"},
+			},
+
+			"remote_ip_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"remote_group_id", "remote_address_group_id"},
+				StateFunc: func(v any) string {
+					return strings.ToLower(v.(string))
+				},
+			},
+
+			"remote_address_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"remote_group_id", "remote_ip_prefix"},
+			},
+
+			"used_in_default_sg": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"used_in_non_default_sg": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingDefaultSecGroupRuleV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	usedInDefaultSG := d.Get("used_in_default_sg").(bool)
+	usedInNonDefaultSG := d.Get("used_in_non_default_sg").(bool)
+	createOpts := networkingDefaultSecGroupRuleV2CreateOpts{
+		Description:          d.Get("description").(string),
+		Direction:            d.Get("direction").(string),
+		EtherType:            d.Get("ethertype").(string),
+		Protocol:             d.Get("protocol").(string),
+		PortRangeMin:         d.Get("port_range_min").(int),
+		PortRangeMax:         d.Get("port_range_max").(int),
+		RemoteIPPrefix:       d.Get("remote_ip_prefix").(string),
+		RemoteGroupID:        d.Get("remote_group_id").(string),
+		RemoteAddressGroupID: d.Get("remote_address_group_id").(string),
+		UsedInDefaultSG:      &usedInDefaultSG,
+		UsedInNonDefaultSG:   &usedInNonDefaultSG,
+	}
+
+	log.Printf("[DEBUG] openstack_networking_default_secgroup_rule_v2 create options: %#v", createOpts)
+
+	rule, err := networkingDefaultSecGroupRuleV2Create(ctx, networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_default_secgroup_rule_v2: %s", err)
+	}
+
+	d.SetId(rule.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_default_secgroup_rule_v2 %s: %#v", rule.ID, rule)
+
+	return resourceNetworkingDefaultSecGroupRuleV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingDefaultSecGroupRuleV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	rule, err := networkingDefaultSecGroupRuleV2Get(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_default_secgroup_rule_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_default_secgroup_rule_v2 %s: %#v", d.Id(), rule)
+
+	d.Set("description", rule.Description)
+	d.Set("direction", rule.Direction)
+	d.Set("ethertype", rule.EtherType)
+	d.Set("protocol", rule.Protocol)
+	d.Set("port_range_min", rule.PortRangeMin)
+	d.Set("port_range_max", rule.PortRangeMax)
+	d.Set("remote_group_id", rule.RemoteGroupID)
+	d.Set("remote_ip_prefix", rule.RemoteIPPrefix)
+	d.Set("remote_address_group_id", rule.RemoteAddressGroupID)
+	d.Set("used_in_default_sg", rule.UsedInDefaultSG)
+	d.Set("used_in_non_default_sg", rule.UsedInNonDefaultSG)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingDefaultSecGroupRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingDefaultSecGroupRuleV2Delete(ctx, networkingClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_default_secgroup_rule_v2"))
+	}
+
+	return nil
+}
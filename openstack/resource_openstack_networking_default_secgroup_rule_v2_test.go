@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2DefaultSecGroupRule_basic(t *testing.T) {
+	var rule networkingDefaultSecGroupRuleV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2DefaultSecGroupRuleDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2DefaultSecGroupRuleBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2DefaultSecGroupRuleExists(t.Context(),
+						"openstack_networking_default_secgroup_rule_v2.rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_default_secgroup_rule_v2.rule_1", "protocol", "tcp"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_default_secgroup_rule_v2.rule_1", "port_range_min", "22"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_default_secgroup_rule_v2.rule_1", "used_in_default_sg", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_default_secgroup_rule_v2.rule_1", "used_in_non_default_sg", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_default_secgroup_rule_v2.rule_2", "remote_group_id", "PARENT"),
+				),
+			},
+			{
+				ResourceName:      "openstack_networking_default_secgroup_rule_v2.rule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2DefaultSecGroupRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_default_secgroup_rule_v2" {
+				continue
+			}
+
+			_, err := networkingDefaultSecGroupRuleV2Get(ctx, networkingClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Default security group rule still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2DefaultSecGroupRuleExists(ctx context.Context, n string, rule *networkingDefaultSecGroupRuleV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingDefaultSecGroupRuleV2Get(ctx, networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Default security group rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2DefaultSecGroupRuleBasic = `
+resource "openstack_networking_default_secgroup_rule_v2" "rule_1" {
+  description      = "ssh from the management network"
+  direction        = "ingress"
+  ethertype        = "IPv4"
+  protocol         = "tcp"
+  port_range_min   = 22
+  port_range_max   = 22
+  remote_ip_prefix = "192.168.199.0/24"
+}
+
+resource "openstack_networking_default_secgroup_rule_v2" "rule_2" {
+  direction          = "ingress"
+  ethertype          = "IPv6"
+  remote_group_id    = "PARENT"
+  used_in_default_sg = true
+}
+`
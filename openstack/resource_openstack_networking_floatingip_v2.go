@@ -3,9 +3,11 @@ package openstack
 import (
 	"context"
 	"log"
+	"net/http"
 	"regexp"
 	"time"
 
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/dns"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
@@ -27,6 +29,7 @@ func resourceNetworkingFloatingIPV2() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -121,6 +124,33 @@ func resourceNetworkingFloatingIPV2() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^$|\.$`), "fully-qualified (unambiguous) DNS domain names must have a dot at the end"),
 			},
+
+			"ptr": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ptrdname": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`\.$`), "fully-qualified (unambiguous) DNS domain names must have a dot at the end"),
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"ttl": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -252,6 +282,20 @@ func resourceNetworkFloatingIPV2Create(ctx context.Context, d *schema.ResourceDa
 		log.Printf("[DEBUG] Set tags %s on openstack_networking_floatingip_v2 %s", tags, fip.ID)
 	}
 
+	if v := d.Get("ptr").([]any); len(v) > 0 && v[0] != nil {
+		dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+		if err != nil {
+			return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		id := dnsFloatingIPPTRV2ID(GetRegion(d, config), fip.ID)
+
+		err = dnsFloatingIPPTRV2Apply(ctx, dnsClient, id, expandNetworkingFloatingIPV2PTR(v), false, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("Error setting PTR record on openstack_networking_floatingip_v2 %s: %s", fip.ID, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Created openstack_networking_floatingip_v2 %s: %#v", fip.ID, fip)
 
 	return resourceNetworkFloatingIPV2Read(ctx, d, meta)
@@ -292,6 +336,21 @@ func resourceNetworkFloatingIPV2Read(ctx context.Context, d *schema.ResourceData
 
 	d.Set("pool", poolName)
 
+	// The PTR record is only tracked when it is managed by this resource.
+	if v := d.Get("ptr").([]any); len(v) > 0 {
+		dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+		if err != nil {
+			return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		ptr, err := dnsFloatingIPPTRV2Get(ctx, dnsClient, dnsFloatingIPPTRV2ID(GetRegion(d, config), d.Id()))
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return diag.Errorf("Error retrieving PTR record of openstack_networking_floatingip_v2 %s: %s", d.Id(), err)
+		}
+
+		d.Set("ptr", flattenNetworkingFloatingIPV2PTR(ptr))
+	}
+
 	return nil
 }
 
@@ -347,6 +406,25 @@ func resourceNetworkFloatingIPV2Update(ctx context.Context, d *schema.ResourceDa
 		log.Printf("[DEBUG] Set tags %s on openstack_networking_floatingip_v2 %s", tags, d.Id())
 	}
 
+	if d.HasChange("ptr") {
+		dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+		if err != nil {
+			return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		id := dnsFloatingIPPTRV2ID(GetRegion(d, config), d.Id())
+
+		if v := d.Get("ptr").([]any); len(v) > 0 && v[0] != nil {
+			err = dnsFloatingIPPTRV2Apply(ctx, dnsClient, id, expandNetworkingFloatingIPV2PTR(v), false, d.Timeout(schema.TimeoutUpdate))
+		} else {
+			err = dnsFloatingIPPTRV2Remove(ctx, dnsClient, id, false, d.Timeout(schema.TimeoutUpdate))
+		}
+
+		if err != nil {
+			return diag.Errorf("Error updating PTR record of openstack_networking_floatingip_v2 %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceNetworkFloatingIPV2Read(ctx, d, meta)
 }
 
@@ -358,6 +436,18 @@ func resourceNetworkFloatingIPV2Delete(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("Error creating OpenStack network client: %s", err)
 	}
 
+	if v := d.Get("ptr").([]any); len(v) > 0 {
+		dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+		if err != nil {
+			return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		err = dnsFloatingIPPTRV2Remove(ctx, dnsClient, dnsFloatingIPPTRV2ID(GetRegion(d, config), d.Id()), false, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.Errorf("Error unsetting PTR record of openstack_networking_floatingip_v2 %s: %s", d.Id(), err)
+		}
+	}
+
 	if err := floatingips.Delete(ctx, networkingClient, d.Id()).ExtractErr(); err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_floatingip_v2"))
 	}
@@ -33,6 +33,38 @@ func TestAccNetworkingV2FloatingIP_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2FloatingIP_ptr(t *testing.T) {
+	var fip floatingips.FloatingIP
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2FloatingIPDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2FloatingIPPTR,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists(t.Context(), "openstack_networking_floatingip_v2.fip_1", &fip),
+					resource.TestCheckResourceAttr("openstack_networking_floatingip_v2.fip_1", "ptr.#", "1"),
+					resource.TestCheckResourceAttr("openstack_networking_floatingip_v2.fip_1", "ptr.0.ptrdname", "mail.example.com."),
+					resource.TestCheckResourceAttr("openstack_networking_floatingip_v2.fip_1", "ptr.0.ttl", "3000"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2FloatingIPBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists(t.Context(), "openstack_networking_floatingip_v2.fip_1", &fip),
+					resource.TestCheckResourceAttr("openstack_networking_floatingip_v2.fip_1", "ptr.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2FloatingIP_fixedip_bind(t *testing.T) {
 	var fip floatingips.FloatingIP
 
@@ -179,6 +211,18 @@ resource "openstack_networking_floatingip_v2" "fip_1" {
 }
 `
 
+const testAccNetworkingV2FloatingIPPTR = `
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  description = "test floating IP"
+
+  ptr {
+    ptrdname    = "mail.example.com."
+    description = "mail server"
+    ttl         = 3000
+  }
+}
+`
+
 func testAccNetworkingV2FloatingIPFixedIPBind1() string {
 	return fmt.Sprintf(`
 resource "openstack_networking_network_v2" "network_1" {
@@ -0,0 +1,199 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingLocalIPV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingLocalIPV2Create,
+		ReadContext:   resourceNetworkingLocalIPV2Read,
+		UpdateContext: resourceNetworkingLocalIPV2Update,
+		DeleteContext: resourceNetworkingLocalIPV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"network_id", "local_port_id"},
+			},
+
+			"local_port_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"network_id", "local_port_id"},
+			},
+
+			"local_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"ip_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"translate", "passthrough",
+				}, false),
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"revision_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingLocalIPV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := networkingLocalIPV2CreateOpts{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		ProjectID:      d.Get("project_id").(string),
+		LocalPortID:    d.Get("local_port_id").(string),
+		NetworkID:      d.Get("network_id").(string),
+		LocalIPAddress: d.Get("local_ip_address").(string),
+		IPMode:         d.Get("ip_mode").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_local_ip_v2 create options: %#v", createOpts)
+
+	localIP, err := networkingLocalIPV2Create(ctx, networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_local_ip_v2: %s", err)
+	}
+
+	d.SetId(localIP.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_local_ip_v2 %s: %#v", localIP.ID, localIP)
+
+	return resourceNetworkingLocalIPV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLocalIPV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	localIP, err := networkingLocalIPV2Get(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_local_ip_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_local_ip_v2 %s: %#v", d.Id(), localIP)
+
+	d.Set("name", localIP.Name)
+	d.Set("description", localIP.Description)
+	d.Set("network_id", localIP.NetworkID)
+	d.Set("local_port_id", localIP.LocalPortID)
+	d.Set("local_ip_address", localIP.LocalIPAddress)
+	d.Set("ip_mode", localIP.IPMode)
+	d.Set("project_id", localIP.ProjectID)
+	d.Set("revision_number", localIP.RevisionNumber)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingLocalIPV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts networkingLocalIPV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_networking_local_ip_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = networkingLocalIPV2Update(ctx, networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_local_ip_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingLocalIPV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLocalIPV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingLocalIPV2Delete(ctx, networkingClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_local_ip_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2LocalIP_basic(t *testing.T) {
+	var localIP networkingLocalIPV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLocalIP(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LocalIPDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2LocalIPExists(t.Context(), "openstack_networking_local_ip_v2.local_ip_1", &localIP),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "name", "local_ip_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "local_ip_address", "192.168.199.100"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_local_ip_v2.local_ip_1", "local_port_id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_association_v2.association_1", "fixed_port_id",
+						"openstack_networking_port_v2.port_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_association_v2.association_1", "local_ip_address", "192.168.199.100"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_association_v2.association_1", "fixed_ip",
+						"openstack_networking_port_v2.port_1", "all_fixed_ips.0"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2LocalIPUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_local_ip_v2.local_ip_1", "id", &localIP.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "name", "local_ip_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "description", "local IP"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2LocalIPDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_local_ip_v2" {
+				continue
+			}
+
+			_, err := networkingLocalIPV2Get(ctx, networkingClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Local IP still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2LocalIPExists(ctx context.Context, n string, localIP *networkingLocalIPV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingLocalIPV2Get(ctx, networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Local IP not found")
+		}
+
+		*localIP = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2LocalIPNetwork = `
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name           = "port_1"
+  admin_state_up = "true"
+  network_id     = openstack_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  }
+}
+`
+
+var testAccNetworkingV2LocalIPBasic = fmt.Sprintf(`
+%s
+
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name             = "local_ip_1"
+  network_id       = openstack_networking_network_v2.network_1.id
+  local_ip_address = "192.168.199.100"
+
+  depends_on = [openstack_networking_subnet_v2.subnet_1]
+}
+
+resource "openstack_networking_local_ip_association_v2" "association_1" {
+  local_ip_id   = openstack_networking_local_ip_v2.local_ip_1.id
+  fixed_port_id = openstack_networking_port_v2.port_1.id
+}
+`, testAccNetworkingV2LocalIPNetwork)
+
+var testAccNetworkingV2LocalIPUpdate = fmt.Sprintf(`
+%s
+
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name             = "local_ip_1_updated"
+  description      = "local IP"
+  network_id       = openstack_networking_network_v2.network_1.id
+  local_ip_address = "192.168.199.100"
+
+  depends_on = [openstack_networking_subnet_v2.subnet_1]
+}
+
+resource "openstack_networking_local_ip_association_v2" "association_1" {
+  local_ip_id   = openstack_networking_local_ip_v2.local_ip_1.id
+  fixed_port_id = openstack_networking_port_v2.port_1.id
+}
+`, testAccNetworkingV2LocalIPNetwork)
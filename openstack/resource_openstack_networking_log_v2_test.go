@@ -0,0 +1,137 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2Log_basic(t *testing.T) {
+	var l networkingLogV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNetworkingLog(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LogDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LogBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2LogExists(t.Context(), "openstack_networking_log_v2.log_1", &l),
+					resource.TestCheckResourceAttr("openstack_networking_log_v2.log_1", "name", "log_1"),
+					resource.TestCheckResourceAttr("openstack_networking_log_v2.log_1", "resource_type", "security_group"),
+					resource.TestCheckResourceAttr("openstack_networking_log_v2.log_1", "event", "DROP"),
+					resource.TestCheckResourceAttr("openstack_networking_log_v2.log_1", "enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_log_v2.log_1", "resource_id",
+						"openstack_networking_secgroup_v2.secgroup_1", "id"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2LogUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2LogExists(t.Context(), "openstack_networking_log_v2.log_1", &l),
+					resource.TestCheckResourceAttr("openstack_networking_log_v2.log_1", "description", "dropped packets"),
+					resource.TestCheckResourceAttr("openstack_networking_log_v2.log_1", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "openstack_networking_log_v2.log_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2LogDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_log_v2" {
+				continue
+			}
+
+			_, err := networkingLogV2Get(ctx, networkingClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Network log still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2LogExists(ctx context.Context, n string, l *networkingLogV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingLogV2Get(ctx, networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Network log not found")
+		}
+
+		*l = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2LogBasic = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+}
+
+resource "openstack_networking_log_v2" "log_1" {
+  name          = "log_1"
+  resource_type = "security_group"
+  resource_id   = openstack_networking_secgroup_v2.secgroup_1.id
+  event         = "DROP"
+}
+`
+
+const testAccNetworkingV2LogUpdate = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+}
+
+resource "openstack_networking_log_v2" "log_1" {
+  name          = "log_1"
+  description   = "dropped packets"
+  resource_type = "security_group"
+  resource_id   = openstack_networking_secgroup_v2.secgroup_1.id
+  event         = "DROP"
+  enabled       = false
+}
+`
@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2MeteringLabelRule_basic(t *testing.T) {
+	var rule networkingMeteringLabelRuleV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2MeteringLabelRuleDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2MeteringLabelRuleBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2MeteringLabelRuleExists(t.Context(),
+						"openstack_networking_metering_label_rule_v2.rule_1", &rule),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_metering_label_rule_v2.rule_1", "metering_label_id",
+						"openstack_networking_metering_label_v2.label_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_metering_label_rule_v2.rule_1", "direction", "egress"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_metering_label_rule_v2.rule_1", "destination_ip_prefix", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_metering_label_rule_v2.rule_2", "excluded", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2MeteringLabelRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_metering_label_rule_v2" {
+				continue
+			}
+
+			_, err := networkingMeteringLabelRuleV2Get(ctx, networkingClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Metering label rule still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2MeteringLabelRuleExists(ctx context.Context, n string, rule *networkingMeteringLabelRuleV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingMeteringLabelRuleV2Get(ctx, networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Metering label rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2MeteringLabelRuleBasic = `
+resource "openstack_networking_metering_label_v2" "label_1" {
+  name = "label_1"
+}
+
+resource "openstack_networking_metering_label_rule_v2" "rule_1" {
+  metering_label_id     = openstack_networking_metering_label_v2.label_1.id
+  direction             = "egress"
+  destination_ip_prefix = "0.0.0.0/0"
+}
+
+resource "openstack_networking_metering_label_rule_v2" "rule_2" {
+  metering_label_id     = openstack_networking_metering_label_v2.label_1.id
+  direction             = "egress"
+  destination_ip_prefix = "10.0.0.0/8"
+  excluded              = true
+}
+`
@@ -0,0 +1,183 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2NDPProxy_basic(t *testing.T) {
+	var proxy networkingNDPProxyV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckNDPProxy(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2NDPProxyDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2NDPProxyBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NDPProxyExists(t.Context(), "openstack_networking_ndp_proxy_v2.proxy_1", &proxy),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "name", "proxy_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "router_id",
+						"openstack_networking_router_v2.router_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "port_id",
+						"openstack_networking_port_v2.port_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "ip_address"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2NDPProxyUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "id", &proxy.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "name", "proxy_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "description", "NDP proxy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2NDPProxyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_ndp_proxy_v2" {
+				continue
+			}
+
+			_, err := networkingNDPProxyV2Get(ctx, networkingClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("NDP proxy still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2NDPProxyExists(ctx context.Context, n string, proxy *networkingNDPProxyV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingNDPProxyV2Get(ctx, networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("NDP proxy not found")
+		}
+
+		*proxy = *found
+
+		return nil
+	}
+}
+
+func testAccNetworkingV2NDPProxyRouter() string {
+	return fmt.Sprintf(`
+resource "openstack_networking_router_v2" "router_1" {
+  name                = "router_1"
+  admin_state_up      = "true"
+  external_network_id = "%s"
+
+  value_specs = {
+    enable_ndp_proxy = "true"
+  }
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name              = "subnet_1"
+  cidr              = "fd00:10::/64"
+  ip_version        = 6
+  ipv6_address_mode = "slaac"
+  ipv6_ra_mode      = "slaac"
+  network_id        = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_router_interface_v2" "int_1" {
+  router_id = openstack_networking_router_v2.router_1.id
+  subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name           = "port_1"
+  admin_state_up = "true"
+  network_id     = openstack_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  }
+}
+`, osExtGwID)
+}
+
+func testAccNetworkingV2NDPProxyBasic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_networking_ndp_proxy_v2" "proxy_1" {
+  name      = "proxy_1"
+  router_id = openstack_networking_router_v2.router_1.id
+  port_id   = openstack_networking_port_v2.port_1.id
+
+  depends_on = [openstack_networking_router_interface_v2.int_1]
+}
+`, testAccNetworkingV2NDPProxyRouter())
+}
+
+func testAccNetworkingV2NDPProxyUpdate() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_networking_ndp_proxy_v2" "proxy_1" {
+  name        = "proxy_1_updated"
+  description = "NDP proxy"
+  router_id   = openstack_networking_router_v2.router_1.id
+  port_id     = openstack_networking_port_v2.port_1.id
+
+  depends_on = [openstack_networking_router_interface_v2.int_1]
+}
+`, testAccNetworkingV2NDPProxyRouter())
+}
@@ -29,6 +29,8 @@ func resourceNetworkingRouterInterfaceV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceNetworkingRouterInterfaceV2CustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
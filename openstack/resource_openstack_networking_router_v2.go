@@ -122,6 +122,53 @@ func resourceNetworkingRouterV2() *schema.Resource {
 				RequiredWith:  []string{"external_network_id"},
 			},
 
+			"external_gateway": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ConflictsWith: []string{
+					"external_network_id", "external_qos_policy_id", "enable_snat",
+					"external_fixed_ip", "external_subnet_ids",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enable_snat": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"qos_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"external_fixed_ip": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"ip_address": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tenant_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -313,6 +360,21 @@ func resourceNetworkingRouterV2Create(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	// Multiple gateways are added after router creation, the first one
+	// becomes the default gateway of the router.
+	if _, ok := d.GetOk("external_gateway"); ok {
+		addOpts := routers.AddExternalGatewaysOpts{
+			ExternalGateways: networkingRouterV2ConfiguredExternalGateways(d),
+		}
+
+		log.Printf("[DEBUG] openstack_networking_router_v2 %s add external gateways options: %#v", router.ID, addOpts)
+
+		_, err = routers.AddExternalGateways(ctx, networkingClient, router.ID, addOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error adding external gateways to openstack_networking_router_v2 %s: %s", router.ID, err)
+		}
+	}
+
 	tags := networkingV2AttributesTags(d)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
@@ -376,6 +438,10 @@ func resourceNetworkingRouterV2Read(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG] Unable to set openstack_networking_router_v2 %s external_fixed_ip: %s", d.Id(), err)
 	}
 
+	if err = d.Set("external_gateway", flattenNetworkingRouterExternalGatewaysV2(router)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_networking_router_v2 %s external_gateway: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -462,6 +528,12 @@ func resourceNetworkingRouterV2Update(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.HasChange("external_gateway") {
+		if err := resourceNetworkingRouterV2UpdateExternalGateways(ctx, d, networkingClient); err != nil {
+			return err
+		}
+	}
+
 	// Next, perform any required updates to the tags.
 	if d.HasChange("tags") {
 		tags := networkingV2UpdateAttributesTags(d)
@@ -508,3 +580,46 @@ func resourceNetworkingRouterV2Delete(ctx context.Context, d *schema.ResourceDat
 
 	return nil
 }
+
+// resourceNetworkingRouterV2UpdateExternalGateways reconciles the external
+// gateways of a router. New gateways are added before removed gateways are
+// deleted to keep the router connected.
+func resourceNetworkingRouterV2UpdateExternalGateways(ctx context.Context, d *schema.ResourceData, client *gophercloud.ServiceClient) diag.Diagnostics {
+	o, _ := d.GetChange("external_gateway")
+	current := expandNetworkingRouterExternalGatewaysV2(o.([]any))
+	desired := networkingRouterV2ConfiguredExternalGateways(d)
+
+	toAdd, toUpdate, toRemove := networkingRouterV2ExternalGatewaysDiff(current, desired)
+
+	if len(toAdd) > 0 {
+		addOpts := routers.AddExternalGatewaysOpts{ExternalGateways: toAdd}
+
+		log.Printf("[DEBUG] openstack_networking_router_v2 %s add external gateways options: %#v", d.Id(), addOpts)
+
+		if _, err := routers.AddExternalGateways(ctx, client, d.Id(), addOpts).Extract(); err != nil {
+			return diag.Errorf("Error adding external gateways to openstack_networking_router_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if len(toUpdate) > 0 {
+		updateOpts := routers.UpdateExternalGatewaysOpts{ExternalGateways: toUpdate}
+
+		log.Printf("[DEBUG] openstack_networking_router_v2 %s update external gateways options: %#v", d.Id(), updateOpts)
+
+		if _, err := routers.UpdateExternalGateways(ctx, client, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("Error updating external gateways of openstack_networking_router_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if len(toRemove) > 0 {
+		removeOpts := routers.RemoveExternalGatewaysOpts{ExternalGateways: toRemove}
+
+		log.Printf("[DEBUG] openstack_networking_router_v2 %s remove external gateways options: %#v", d.Id(), removeOpts)
+
+		if _, err := routers.RemoveExternalGateways(ctx, client, d.Id(), removeOpts).Extract(); err != nil {
+			return diag.Errorf("Error removing external gateways from openstack_networking_router_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -243,6 +243,42 @@ func TestAccNetworkingV2Router_extIPAddress(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Router_externalGateways(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckRouterMultihoming(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2RouterDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterExternalGateways(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "external_gateway.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "external_gateway.0.network_id", osExtGwID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "external_gateway.1.enable_snat", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "external_network_id", osExtGwID),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterExternalGateways(1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "external_gateway.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "external_network_id", osExtGwID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2RouterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -489,3 +525,20 @@ resource "openstack_networking_router_v2" "router_1" {
 }
 `, osExtGwID, osExtGwID)
 }
+
+func testAccNetworkingV2RouterExternalGateways(count int) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+
+  dynamic "external_gateway" {
+    for_each = range(%d)
+
+    content {
+      network_id  = "%s"
+      enable_snat = external_gateway.key == 0
+    }
+  }
+}
+`, count, osExtGwID)
+}
@@ -0,0 +1,279 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingSecGroupRulesV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingSecGroupRulesV2Create,
+		ReadContext:   resourceNetworkingSecGroupRulesV2Read,
+		UpdateContext: resourceNetworkingSecGroupRulesV2Update,
+		DeleteContext: resourceNetworkingSecGroupRulesV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      networkingSecGroupRulesV2Hash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: resourceNetworkingSecGroupRuleV2Direction,
+						},
+
+						"ethertype": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(rules.EtherType4),
+							ValidateFunc: resourceNetworkingSecGroupRuleV2EtherType,
+						},
+
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: resourceNetworkingSecGroupRuleV2Protocol,
+						},
+
+						"port_range_min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"port_range_max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"remote_ip_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"remote_address_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkingSecGroupRulesV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	securityGroupID := d.Get("security_group_id").(string)
+	config.Lock(securityGroupID)
+	defer config.Unlock(securityGroupID)
+
+	if err := resourceNetworkingSecGroupRulesV2Apply(ctx, d, networkingClient, securityGroupID); err != nil {
+		return err
+	}
+
+	d.SetId(securityGroupID)
+
+	log.Printf("[DEBUG] Created openstack_networking_secgroup_rules_v2 %s", securityGroupID)
+
+	return resourceNetworkingSecGroupRulesV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	sg, err := groups.Get(ctx, networkingClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_secgroup_rules_v2 security group"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_secgroup_rules_v2 %s: %#v", d.Id(), sg.Rules)
+
+	allRules := flattenNetworkingSecGroupRulesV2(sg.Rules, d.Get("rule").(*schema.Set).List())
+
+	d.Set("security_group_id", sg.ID)
+	d.Set("rule", allRules)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingSecGroupRulesV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if d.HasChange("rule") {
+		config.Lock(d.Id())
+		defer config.Unlock(d.Id())
+
+		if err := resourceNetworkingSecGroupRulesV2Apply(ctx, d, networkingClient, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetworkingSecGroupRulesV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	config.Lock(d.Id())
+	defer config.Unlock(d.Id())
+
+	actual, err := networkingSecGroupRulesV2List(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_secgroup_rules_v2"))
+	}
+
+	// Remove all rules known to the state, which includes rules added
+	// outside of Terraform before the last refresh.
+	managed := d.Get("rule").(*schema.Set)
+
+	for _, r := range actual {
+		if !managed.Contains(flattenNetworkingSecGroupRulesV2Rule(r)) {
+			continue
+		}
+
+		if err := networkingSecGroupRulesV2Delete(ctx, networkingClient, r.ID); err != nil {
+			return diag.Errorf("Error deleting rule %s of openstack_networking_secgroup_rules_v2 %s: %s", r.ID, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// resourceNetworkingSecGroupRulesV2Apply reconciles the actual rules of the
+// security group with the configured ones. New rules are created before
+// removed rules are deleted to avoid interrupting traffic. Rules with a
+// changed description are deleted right before they are created again.
+func resourceNetworkingSecGroupRulesV2Apply(ctx context.Context, d *schema.ResourceData, client *gophercloud.ServiceClient, securityGroupID string) diag.Diagnostics {
+	actual, err := networkingSecGroupRulesV2List(ctx, client, securityGroupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	changes, err := networkingSecGroupRulesV2Diff(actual, d.Get("rule").(*schema.Set).List())
+	if err != nil {
+		return diag.Errorf("Invalid rules for openstack_networking_secgroup_rules_v2 %s: %s", securityGroupID, err)
+	}
+
+	for _, r := range changes.Replace {
+		log.Printf("[DEBUG] Deleting rule %s of openstack_networking_secgroup_rules_v2 %s to change its description", r.Old.ID, securityGroupID)
+
+		if err := networkingSecGroupRulesV2Delete(ctx, client, r.Old.ID); err != nil {
+			return diag.Errorf("Error deleting rule %s of openstack_networking_secgroup_rules_v2 %s: %s", r.Old.ID, securityGroupID, err)
+		}
+
+		if err := networkingSecGroupRulesV2Create(ctx, client, securityGroupID, r.New); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range changes.Create {
+		if err := networkingSecGroupRulesV2Create(ctx, client, securityGroupID, rule); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range changes.Delete {
+		log.Printf("[DEBUG] Deleting rule %s of openstack_networking_secgroup_rules_v2 %s", r.ID, securityGroupID)
+
+		if err := networkingSecGroupRulesV2Delete(ctx, client, r.ID); err != nil {
+			return diag.Errorf("Error deleting rule %s of openstack_networking_secgroup_rules_v2 %s: %s", r.ID, securityGroupID, err)
+		}
+	}
+
+	return nil
+}
+
+func networkingSecGroupRulesV2Create(ctx context.Context, client *gophercloud.ServiceClient, securityGroupID string, rule map[string]any) diag.Diagnostics {
+	opts, err := expandNetworkingSecGroupRulesV2CreateOpts(securityGroupID, rule)
+	if err != nil {
+		return diag.Errorf("Invalid rule for openstack_networking_secgroup_rules_v2 %s: %s", securityGroupID, err)
+	}
+
+	log.Printf("[DEBUG] openstack_networking_secgroup_rules_v2 %s rule create options: %#v", securityGroupID, opts)
+
+	r, err := rules.Create(ctx, client, opts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating rule for openstack_networking_secgroup_rules_v2 %s: %s", securityGroupID, err)
+	}
+
+	log.Printf("[DEBUG] Created rule %s for openstack_networking_secgroup_rules_v2 %s", r.ID, securityGroupID)
+
+	return nil
+}
+
+func networkingSecGroupRulesV2Delete(ctx context.Context, client *gophercloud.ServiceClient, ruleID string) error {
+	err := rules.Delete(ctx, client, ruleID).ExtractErr()
+	if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return err
+	}
+
+	return nil
+}
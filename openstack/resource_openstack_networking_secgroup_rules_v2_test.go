@@ -0,0 +1,107 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkingV2SecGroupRules_basic(t *testing.T) {
+	var secgroup groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRulesBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(t.Context(),
+						"openstack_networking_secgroup_v2.secgroup_1", &secgroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&secgroup, 2),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_rules_v2.rules_1", "rule.#", "2"),
+				),
+			},
+			// The update config uses a protocol number, while an import
+			// reads the protocol names returned by Neutron, so the import is
+			// verified against the basic config.
+			{
+				ResourceName:      "openstack_networking_secgroup_rules_v2.rules_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(t.Context(),
+						"openstack_networking_secgroup_v2.secgroup_1", &secgroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&secgroup, 3),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_rules_v2.rules_1", "rule.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2SecGroupRulesBasic = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name                 = "secgroup_1"
+  description          = "terraform security group rules acceptance test"
+  delete_default_rules = true
+}
+
+resource "openstack_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = openstack_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    direction        = "ingress"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction = "egress"
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRulesUpdate = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name                 = "secgroup_1"
+  description          = "terraform security group rules acceptance test"
+  delete_default_rules = true
+}
+
+resource "openstack_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = openstack_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    direction        = "ingress"
+    protocol         = "6"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction      = "ingress"
+    protocol       = "tcp"
+    port_range_min = 443
+    port_range_max = 443
+    description    = "https"
+  }
+
+  rule {
+    direction = "egress"
+  }
+}
+`
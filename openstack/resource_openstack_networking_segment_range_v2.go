@@ -0,0 +1,243 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingSegmentRangeV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingSegmentRangeV2Create,
+		ReadContext:   resourceNetworkingSegmentRangeV2Read,
+		UpdateContext: resourceNetworkingSegmentRangeV2Update,
+		DeleteContext: resourceNetworkingSegmentRangeV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.All(
+			resourceNetworkingSegmentRangeV2CustomizeDiff,
+			resourceNetworkingSegmentRangeV2CustomizeDiffRange,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"network_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vlan",
+					"vxlan",
+					"gre",
+					"geneve",
+				}, false),
+			},
+
+			"physical_network": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"minimum": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"maximum": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"shared": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"revision_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceNetworkingSegmentRangeV2CustomizeDiff validates shared and
+// project_id at plan time. An existing segment range, whose project_id is
+// only known from the state, is not validated.
+func resourceNetworkingSegmentRangeV2CustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() != "" && !d.HasChanges("shared", "project_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("shared") {
+		return nil
+	}
+
+	projectID := d.GetRawConfig().GetAttr("project_id")
+
+	return networkingSegmentRangeV2ValidateProject(d.Get("shared").(bool), !projectID.IsNull())
+}
+
+// resourceNetworkingSegmentRangeV2CustomizeDiffRange validates minimum and
+// maximum at plan time, unless one of them is not known yet.
+func resourceNetworkingSegmentRangeV2CustomizeDiffRange(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("minimum") || !d.NewValueKnown("maximum") {
+		return nil
+	}
+
+	return networkingSegmentRangeV2ValidateRange(d.Get("minimum").(int), d.Get("maximum").(int))
+}
+
+func resourceNetworkingSegmentRangeV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	minimum := d.Get("minimum").(int)
+	maximum := d.Get("maximum").(int)
+	shared := d.Get("shared").(bool)
+	createOpts := networkingSegmentRangeV2CreateOpts{
+		Name:            d.Get("name").(string),
+		Shared:          &shared,
+		ProjectID:       d.Get("project_id").(string),
+		NetworkType:     d.Get("network_type").(string),
+		PhysicalNetwork: d.Get("physical_network").(string),
+		Minimum:         minimum,
+		Maximum:         maximum,
+	}
+
+	log.Printf("[DEBUG] openstack_networking_segment_range_v2 create options: %#v", createOpts)
+
+	r, err := networkingSegmentRangeV2Create(ctx, networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_segment_range_v2: %s", err)
+	}
+
+	d.SetId(r.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_segment_range_v2 %s: %#v", r.ID, r)
+
+	return resourceNetworkingSegmentRangeV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingSegmentRangeV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	r, err := networkingSegmentRangeV2Get(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_segment_range_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_segment_range_v2 %s: %#v", d.Id(), r)
+
+	d.Set("name", r.Name)
+	d.Set("network_type", r.NetworkType)
+	d.Set("physical_network", r.PhysicalNetwork)
+	d.Set("minimum", r.Minimum)
+	d.Set("maximum", r.Maximum)
+	d.Set("shared", r.Shared)
+	d.Set("project_id", r.ProjectID)
+	d.Set("default", r.Default)
+	d.Set("revision_number", r.RevisionNumber)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingSegmentRangeV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts networkingSegmentRangeV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	// Neutron allows to resize a range, as long as it still contains all
+	// the segmentation IDs in use.
+	if d.HasChanges("minimum", "maximum") {
+		hasChange = true
+		minimum := d.Get("minimum").(int)
+		maximum := d.Get("maximum").(int)
+		updateOpts.Minimum = &minimum
+		updateOpts.Maximum = &maximum
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_networking_segment_range_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = networkingSegmentRangeV2Update(ctx, networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_segment_range_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingSegmentRangeV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingSegmentRangeV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingSegmentRangeV2Delete(ctx, networkingClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_segment_range_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetworkingTrunkSubportV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingTrunkSubportV2Create,
+		ReadContext:   resourceNetworkingTrunkSubportV2Read,
+		DeleteContext: resourceNetworkingTrunkSubportV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"trunk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"segmentation_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"segmentation_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingTrunkSubportV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	trunkID := d.Get("trunk_id").(string)
+	portID := d.Get("port_id").(string)
+
+	addOpts := trunks.AddSubportsOpts{
+		Subports: []trunks.Subport{
+			{
+				PortID:           portID,
+				SegmentationType: d.Get("segmentation_type").(string),
+				SegmentationID:   d.Get("segmentation_id").(int),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] openstack_networking_trunk_subport_v2 create options: %#v", addOpts)
+
+	config.Lock(trunkID)
+	defer config.Unlock(trunkID)
+
+	_, err = trunks.AddSubports(ctx, client, trunkID, addOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error adding openstack_networking_trunk_subport_v2 port %s to trunk %s: %s", portID, trunkID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", trunkID, portID))
+
+	log.Printf("[DEBUG] Created openstack_networking_trunk_subport_v2 %s", d.Id())
+
+	return resourceNetworkingTrunkSubportV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingTrunkSubportV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	trunkID, portID, err := parsePairedIDs(d.Id(), "openstack_networking_trunk_subport_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subports, err := trunks.GetSubports(ctx, client, trunkID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_trunk_subport_v2 trunk"))
+	}
+
+	subport, ok := networkingTrunkV2FindSubport(subports, portID)
+	if !ok {
+		log.Printf("[DEBUG] openstack_networking_trunk_subport_v2 %s not found, removing it from state", d.Id())
+		d.SetId("")
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_trunk_subport_v2 %s: %#v", d.Id(), subport)
+
+	d.Set("trunk_id", trunkID)
+	d.Set("port_id", subport.PortID)
+	d.Set("segmentation_type", subport.SegmentationType)
+	d.Set("segmentation_id", subport.SegmentationID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingTrunkSubportV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	trunkID, portID, err := parsePairedIDs(d.Id(), "openstack_networking_trunk_subport_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	removeOpts := trunks.RemoveSubportsOpts{
+		Subports: []trunks.RemoveSubport{
+			{PortID: portID},
+		},
+	}
+
+	config.Lock(trunkID)
+	defer config.Unlock(trunkID)
+
+	_, err = trunks.RemoveSubports(ctx, client, trunkID, removeOpts).Extract()
+	if err != nil {
+		// Neutron responds with 404, when either the trunk or the subport
+		// is gone already.
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_trunk_subport_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2TrunkSubport_basic(t *testing.T) {
+	var subport trunks.Subport
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2TrunkSubportDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2TrunkSubportBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2TrunkSubportExists(t.Context(), "openstack_networking_trunk_subport_v2.subport_1", &subport),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_trunk_subport_v2.subport_1", "segmentation_type", "vlan"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_trunk_subport_v2.subport_1", "segmentation_id", "100"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_trunk_subport_v2.subport_1", "trunk_id",
+						"openstack_networking_trunk_v2.trunk_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_trunk_v2.trunk_1", "sub_port.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2TrunkSubportDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		client, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_trunk_subport_v2" {
+				continue
+			}
+
+			subports, err := trunks.GetSubports(ctx, client, rs.Primary.Attributes["trunk_id"]).Extract()
+			if err != nil {
+				continue
+			}
+
+			if _, ok := networkingTrunkV2FindSubport(subports, rs.Primary.Attributes["port_id"]); ok {
+				return errors.New("Trunk subport still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2TrunkSubportExists(ctx context.Context, n string, subport *trunks.Subport) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		client, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		trunkID, portID, err := parsePairedIDs(rs.Primary.ID, "openstack_networking_trunk_subport_v2")
+		if err != nil {
+			return err
+		}
+
+		subports, err := trunks.GetSubports(ctx, client, trunkID).Extract()
+		if err != nil {
+			return err
+		}
+
+		found, ok := networkingTrunkV2FindSubport(subports, portID)
+		if !ok {
+			return errors.New("Trunk subport not found")
+		}
+
+		*subport = found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2TrunkSubportBasic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_port_v2" "parent_port_1" {
+  name = "parent_port_1"
+  admin_state_up = "true"
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_port_v2" "subport_1" {
+  name = "subport_1"
+  admin_state_up = "true"
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_trunk_v2" "trunk_1" {
+  name = "trunk_1"
+  port_id = openstack_networking_port_v2.parent_port_1.id
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_trunk_subport_v2" "subport_1" {
+  trunk_id = openstack_networking_trunk_v2.trunk_1.id
+  port_id = openstack_networking_port_v2.subport_1.id
+  segmentation_type = "vlan"
+  segmentation_id = 100
+}
+`
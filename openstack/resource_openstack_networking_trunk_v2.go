@@ -62,9 +62,13 @@ func resourceNetworkingTrunkV2() *schema.Resource {
 				Computed: true,
 			},
 
+			// sub_port is computed, so that subports managed with
+			// openstack_networking_trunk_subport_v2 don't cause a diff, when
+			// no sub_port is configured.
 			"sub_port": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				ForceNew: false,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -1,6 +1,7 @@
 package openstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -50,6 +52,16 @@ func GetRegion(d *schema.ResourceData, config *Config) string {
 	return config.Region
 }
 
+// GetRegionFromResourceDiff is the GetRegion equivalent for CustomizeDiff
+// functions.
+func GetRegionFromResourceDiff(d *schema.ResourceDiff, config *Config) string {
+	if v, ok := d.GetOk("region"); ok {
+		return v.(string)
+	}
+
+	return config.Region
+}
+
 // AddValueSpecs expands the 'value_specs' object and removes 'value_specs'
 // from the reqeust body.
 func AddValueSpecs(body map[string]any) map[string]any {
@@ -382,3 +394,72 @@ func bumpClientMicroversion(client *gophercloud.ServiceClient, requiredMicrovers
 		client.Microversion = requiredMicroversion
 	}
 }
+
+// rawListPage is a page of a collection, which is listed without a
+// gophercloud List function. key is the name of the collection in the
+// response body.
+type rawListPage struct {
+	pagination.LinkedPageBase
+
+	key string
+}
+
+// NextPageURL supports both the Designate "links": {"next": ...} style and
+// the "<key>_links": [{"rel": "next", ...}] style of the other services.
+func (p rawListPage) NextPageURL() (string, error) {
+	body, ok := p.Body.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("unexpected response body type: %T", p.Body)
+	}
+
+	if _, ok := body[p.key+"_links"]; !ok {
+		return p.LinkedPageBase.NextPageURL()
+	}
+
+	var links []gophercloud.Link
+
+	err := p.ExtractIntoSlicePtr(&links, p.key+"_links")
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(links)
+}
+
+func (p rawListPage) IsEmpty() (bool, error) {
+	if body, ok := p.Body.(map[string]any); ok {
+		items, _ := body[p.key].([]any)
+
+		return len(items) == 0, nil
+	}
+
+	return true, nil
+}
+
+// listAllPages lists all items of the key collection at url and follows the
+// next links of the responses.
+func listAllPages[T any](ctx context.Context, client *gophercloud.ServiceClient, url, key string) ([]T, error) {
+	var allItems []T
+
+	pager := pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return rawListPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}, key: key}
+	})
+
+	err := pager.EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
+		var items []T
+
+		err := page.(rawListPage).ExtractIntoSlicePtr(&items, key)
+		if err != nil {
+			return false, err
+		}
+
+		allItems = append(allItems, items...)
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allItems, nil
+}
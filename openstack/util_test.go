@@ -1,8 +1,12 @@
 package openstack
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	thclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -157,3 +161,46 @@ func TestUnitStringSliceToSet(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitListAllPages(t *testing.T) {
+	type item struct {
+		ID string `json:"id"`
+	}
+
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	// Neutron, Octavia and Cinder style links.
+	fakeServer.Mux.HandleFunc("/items", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("marker") {
+		case "":
+			fmt.Fprintf(w, `{"items": [{"id": "1"}, {"id": "2"}], "items_links": [{"rel": "next", "href": "%s/items?marker=2"}]}`, fakeServer.Endpoint())
+		case "2":
+			fmt.Fprint(w, `{"items": [{"id": "3"}], "items_links": [{"rel": "previous", "href": "unused"}]}`)
+		}
+	})
+
+	// Designate style links.
+	fakeServer.Mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("marker") {
+		case "":
+			fmt.Fprintf(w, `{"zones": [{"id": "1"}], "links": {"self": "unused", "next": "%s/zones?marker=1"}}`, fakeServer.Endpoint())
+		case "1":
+			fmt.Fprintf(w, `{"zones": [{"id": "2"}], "links": {"self": "%s/zones?marker=1"}}`, fakeServer.Endpoint())
+		}
+	})
+
+	client := thclient.ServiceClient(fakeServer)
+
+	items, err := listAllPages[item](t.Context(), client, client.ServiceURL("items"), "items")
+	require.NoError(t, err)
+	assert.Equal(t, []item{{"1"}, {"2"}, {"3"}}, items)
+
+	zones, err := listAllPages[item](t.Context(), client, client.ServiceURL("zones"), "zones")
+	require.NoError(t, err)
+	assert.Equal(t, []item{{"1"}, {"2"}}, zones)
+}